@@ -0,0 +1,112 @@
+package beater
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+const (
+	archiveHourLayout = "2006/01/02/15"
+	archiveFileLayout = "20060102T150405Z"
+	archiveExtension  = ".pb.gz"
+	//archivePruneEvery how often archives past their retention are looked for
+	archivePruneEvery = time.Hour
+)
+
+//archiver writes the raw feed messages of a feed to an hourly directory structure
+type archiver struct {
+	root       string
+	retention  time.Duration
+	lastPruned time.Time
+}
+
+func newArchiver(c config.ArchiveConfig, feed string) *archiver {
+	// Feed names are free text, keep them from escaping the archive
+	name := strings.NewReplacer("/", "_", "\\", "_", "..", "_").Replace(feed)
+	return &archiver{
+		root:      filepath.Join(c.Path, name),
+		retention: c.Retention,
+	}
+}
+
+//archivePath where the message produced at timestamp is archived
+func (a *archiver) archivePath(timestamp time.Time) string {
+	timestamp = timestamp.UTC()
+	return filepath.Join(a.root, filepath.FromSlash(timestamp.Format(archiveHourLayout)), timestamp.Format(archiveFileLayout)+archiveExtension)
+}
+
+//Write archives the body of the message produced at timestamp, unless it was already archived
+func (a *archiver) Write(data []byte, timestamp time.Time) error {
+	path := a.archivePath(timestamp)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	// Written aside and renamed so that readers never see a partial archive
+	tmp, err := ioutil.TempFile(dir, ".archive")
+	if err != nil {
+		return err
+	}
+	writer := gzip.NewWriter(tmp)
+	_, err = writer.Write(data)
+	if closeErr := writer.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if a.retention > 0 && time.Since(a.lastPruned) >= archivePruneEvery {
+		a.Prune(time.Now())
+	}
+	return nil
+}
+
+//Prune removes the hourly directories that are past the retention, along with emptied parents
+func (a *archiver) Prune(now time.Time) {
+	a.lastPruned = now
+	cutoff := now.Add(-a.retention)
+	hours, err := filepath.Glob(filepath.Join(a.root, "*", "*", "*", "*"))
+	if err != nil {
+		logp.Err("Error listing archives of %s: %v", a.root, err)
+		return
+	}
+	for _, hour := range hours {
+		relative, err := filepath.Rel(a.root, hour)
+		if err != nil {
+			continue
+		}
+		start, err := time.Parse(archiveHourLayout, filepath.ToSlash(relative))
+		if err != nil || !start.Add(time.Hour).Before(cutoff) {
+			continue
+		}
+		logp.Debug("gtfsbeat", "Removing archives %s", hour)
+		if err := os.RemoveAll(hour); err != nil {
+			logp.Err("Error removing archives %s: %v", hour, err)
+			continue
+		}
+		// Remove emptied day, month and year directories, failing on the first that is not empty
+		for dir := filepath.Dir(hour); dir != a.root; dir = filepath.Dir(dir) {
+			if os.Remove(dir) != nil {
+				break
+			}
+		}
+	}
+}
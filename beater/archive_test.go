@@ -0,0 +1,56 @@
+// +build !integration
+
+package beater
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+func TestArchiverWritesAndPrunes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gtfsbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	a := newArchiver(config.ArchiveConfig{Path: dir, Retention: 24 * time.Hour}, "city/vehicles")
+	// Pruned explicitly below
+	a.lastPruned = time.Now()
+	old := time.Date(2019, 3, 1, 10, 15, 0, 0, time.UTC)
+	recent := time.Date(2019, 3, 3, 9, 30, 5, 0, time.UTC)
+	for _, timestamp := range []time.Time{old, recent} {
+		if err := a.Write([]byte("message"), timestamp); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	path := filepath.Join(dir, "city_vehicles", "2019", "03", "03", "09", "20190303T093005Z.pb.gz")
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("expected archive %s: %v", path, err)
+	}
+	defer file.Close()
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := ioutil.ReadAll(reader); string(data) != "message" {
+		t.Errorf("unexpected archive content %q", data)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "city_vehicles", "2019", "03", "01", "10")); err != nil {
+		t.Fatalf("expected the old archive to be written, got %v", err)
+	}
+	a.Prune(time.Date(2019, 3, 3, 12, 0, 0, 0, time.UTC))
+	if _, err := os.Stat(filepath.Join(dir, "city_vehicles", "2019", "03", "01")); !os.IsNotExist(err) {
+		t.Errorf("expected the old day to be removed, got %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("expected the recent archive to be kept, got %v", err)
+	}
+}
@@ -0,0 +1,159 @@
+package beater
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+//tokenExpiryMargin how long before its expiry an access token is refreshed
+const tokenExpiryMargin = 30 * time.Second
+
+//authenticator adds the configured credentials to the requests of a feed
+type authenticator struct {
+	config config.AuthConfig
+	client *http.Client
+	mutex  sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+func newAuthenticator(c config.AuthConfig, client *http.Client) *authenticator {
+	return &authenticator{config: c, client: client}
+}
+
+//Authorize adds the headers, query parameters and credentials to the request,
+//requesting a new oauth2 access token when the current one expires
+func (a *authenticator) Authorize(req *http.Request) error {
+	if len(a.config.Params) > 0 {
+		query := req.URL.Query()
+		for name, value := range a.config.Params {
+			query.Set(name, value)
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+	for name, value := range a.config.Headers {
+		req.Header.Set(name, value)
+	}
+	switch {
+	case a.config.Username != "":
+		req.SetBasicAuth(a.config.Username, a.config.Password)
+	case a.config.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+a.config.BearerToken)
+	case a.config.OAuth2 != nil:
+		token, err := a.accessToken(time.Now())
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return nil
+}
+
+//redactedValue replaces the query values of request urls in errors
+const redactedValue = "REDACTED"
+
+//Redact hides the query values of the url in a request error, as they may hold
+//the api key of the feed, before the error is logged or published
+func (a *authenticator) Redact(err error) error {
+	urlErr, ok := err.(*url.Error)
+	if !ok {
+		return err
+	}
+	redacted := *urlErr
+	if u, parseErr := url.Parse(urlErr.URL); parseErr == nil && u.RawQuery != "" {
+		query := u.Query()
+		for name := range query {
+			query.Set(name, redactedValue)
+		}
+		u.RawQuery = query.Encode()
+		redacted.URL = u.String()
+	} else if parseErr != nil {
+		redacted.URL = redactedValue
+	}
+	message := redacted.Err.Error()
+	for _, value := range a.config.Params {
+		if value != "" && strings.Contains(message, value) {
+			redacted.Err = errors.New(strings.Replace(message, value, redactedValue, -1))
+			message = redacted.Err.Error()
+		}
+	}
+	return &redacted
+}
+
+//Invalidate drops the current access token, for instance after it was rejected
+func (a *authenticator) Invalidate() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.token = ""
+}
+
+func (a *authenticator) accessToken(now time.Time) (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.token != "" && (a.expiry.IsZero() || now.Before(a.expiry.Add(-tokenExpiryMargin))) {
+		return a.token, nil
+	}
+	token, expiresIn, err := requestToken(a.client, a.config.OAuth2)
+	if err != nil {
+		return "", err
+	}
+	a.token = token
+	a.expiry = time.Time{}
+	if expiresIn > 0 {
+		a.expiry = now.Add(expiresIn)
+	}
+	logp.Debug("gtfsbeat", "Received oauth2 access token from %s expiring in %s", a.config.OAuth2.TokenURL, expiresIn)
+	return a.token, nil
+}
+
+//tokenResponse the successful response of an oauth2 token endpoint
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+//requestToken requests an access token with the client credentials grant
+func requestToken(client *http.Client, c *config.OAuth2Config) (string, time.Duration, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if len(c.Scopes) > 0 {
+		form.Set("scope", strings.Join(c.Scopes, " "))
+	}
+	for name, value := range c.EndpointParams {
+		form.Set(name, value)
+	}
+	req, err := http.NewRequest("POST", c.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(c.ClientID), url.QueryEscape(c.ClientSecret))
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", 0, fmt.Errorf("Error requesting oauth2 token from %s: %s", c.TokenURL, resp.Status)
+	}
+	token := tokenResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", 0, fmt.Errorf("Error decoding oauth2 token from %s: %v", c.TokenURL, err)
+	}
+	if token.AccessToken == "" {
+		return "", 0, fmt.Errorf("Missing access token in oauth2 response from %s", c.TokenURL)
+	}
+	return token.AccessToken, time.Duration(token.ExpiresIn) * time.Second, nil
+}
@@ -0,0 +1,109 @@
+// +build !integration
+
+package beater
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+func TestAuthorizeHeadersParamsAndBasic(t *testing.T) {
+	auth := newAuthenticator(config.AuthConfig{
+		Headers:  map[string]string{"x-api-key": "key"},
+		Params:   map[string]string{"api_key": "secret"},
+		Username: "user",
+		Password: "pass",
+	}, http.DefaultClient)
+	req, _ := http.NewRequest("GET", "http://localhost/feed.pb?format=pb", nil)
+	if err := auth.Authorize(req); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if req.Header.Get("x-api-key") != "key" {
+		t.Errorf("unexpected headers %v", req.Header)
+	}
+	if req.URL.Query().Get("api_key") != "secret" || req.URL.Query().Get("format") != "pb" {
+		t.Errorf("unexpected query %s", req.URL.RawQuery)
+	}
+	if username, password, ok := req.BasicAuth(); !ok || username != "user" || password != "pass" {
+		t.Errorf("unexpected basic auth %s:%s", username, password)
+	}
+}
+
+func TestAuthorizeOAuth2RefreshesToken(t *testing.T) {
+	issued := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, secret, ok := r.BasicAuth(); !ok || id != "id" || secret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("scope") != "read feeds" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		issued++
+		fmt.Fprintf(w, `{"access_token":"token%d","token_type":"bearer","expires_in":60}`, issued)
+	}))
+	defer server.Close()
+
+	auth := newAuthenticator(config.AuthConfig{OAuth2: &config.OAuth2Config{
+		TokenURL:     server.URL,
+		ClientID:     "id",
+		ClientSecret: "secret",
+		Scopes:       []string{"read", "feeds"},
+	}}, http.DefaultClient)
+	now := time.Now()
+	for _, test := range []struct {
+		now   time.Time
+		token string
+	}{
+		{now, "token1"},
+		{now.Add(20 * time.Second), "token1"},
+		{now.Add(40 * time.Second), "token2"},
+	} {
+		token, err := auth.accessToken(test.now)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if token != test.token {
+			t.Errorf("expected %s, got %s", test.token, token)
+		}
+	}
+	auth.Invalidate()
+	req, _ := http.NewRequest("GET", "http://localhost/feed.pb", nil)
+	if err := auth.Authorize(req); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if req.Header.Get("Authorization") != "Bearer token3" {
+		t.Errorf("unexpected authorization %s", req.Header.Get("Authorization"))
+	}
+}
+
+func TestUnreachableFeedHidesParams(t *testing.T) {
+	// A closed server refuses the connection
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	c := config.FeedConfig{
+		Name:       "secret",
+		URL:        server.URL + "/feed.pb",
+		AuthConfig: config.AuthConfig{Params: map[string]string{"api_key": "SUPERSECRET"}},
+	}
+	f := NewFeed(c, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	if _, err := f.GetGtfsFeed(); err == nil || strings.Contains(err.Error(), "SUPERSECRET") || !strings.Contains(err.Error(), "api_key=REDACTED") {
+		t.Errorf("expected the api key to be redacted, got %v", err)
+	}
+	if !retryable(f.auth.Redact(&url.Error{Op: "Get", URL: c.URL + "?api_key=SUPERSECRET", Err: fmt.Errorf("refused")})) {
+		t.Error("expected a redacted request error to stay retryable")
+	}
+	f.poll(make(chan struct{}))
+	event := f.healthEvent(time.Now())
+	if lastError, _ := event.GetValue("health.last_error"); lastError == nil || strings.Contains(lastError.(string), "SUPERSECRET") {
+		t.Errorf("expected the api key to be redacted, got %v", lastError)
+	}
+}
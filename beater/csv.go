@@ -0,0 +1,197 @@
+package beater
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const utf8BOM = "\ufeff"
+
+//parseError locates a value of a gtfs file that could not be parsed
+type parseError struct {
+	File   string
+	Row    int
+	Column string
+	Err    error
+}
+
+func (e *parseError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("%s:%d: %v", e.File, e.Row, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: column %s: %v", e.File, e.Row, e.Column, e.Err)
+}
+
+//csvReader reads a gtfs csv file mapping each column by its header name.
+//Value getters record the first error of a row, available through Err.
+type csvReader struct {
+	name     string
+	reader   *csv.Reader
+	columns  map[string]int
+	required []string
+	row      []string
+	line     int
+	err      error
+}
+
+//newCSVReader reads the header of a gtfs file, failing if a required column is missing
+func newCSVReader(name string, r io.Reader, required ...string) (*csvReader, error) {
+	buffered := bufio.NewReader(r)
+	if bom, err := buffered.Peek(len(utf8BOM)); err == nil && string(bom) == utf8BOM {
+		buffered.Discard(len(utf8BOM))
+	}
+	reader := csv.NewReader(buffered)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, &parseError{File: name, Row: 1, Err: fmt.Errorf("missing header")}
+	}
+	if err != nil {
+		return nil, &parseError{File: name, Row: 1, Err: err}
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+	for _, column := range required {
+		if _, ok := columns[column]; !ok {
+			return nil, &parseError{File: name, Row: 1, Column: column, Err: fmt.Errorf("missing required column")}
+		}
+	}
+	return &csvReader{
+		name:     name,
+		reader:   reader,
+		columns:  columns,
+		required: required,
+		line:     1,
+	}, nil
+}
+
+//Next advances to the next row, returning false at the end of the file
+func (r *csvReader) Next() (bool, error) {
+	row, err := r.reader.Read()
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		if csvErr, ok := err.(*csv.ParseError); ok {
+			return false, &parseError{File: r.name, Row: csvErr.Line, Err: csvErr.Err}
+		}
+		return false, &parseError{File: r.name, Row: r.line + 1, Err: err}
+	}
+	r.line++
+	r.row = row
+	r.err = nil
+	for _, column := range r.required {
+		if r.String(column) == "" {
+			r.fail(column, fmt.Errorf("missing required value"))
+		}
+	}
+	return true, nil
+}
+
+//Err the first error encountered in the current row
+func (r *csvReader) Err() error {
+	return r.err
+}
+
+func (r *csvReader) fail(column string, err error) {
+	if r.err == nil {
+		r.err = &parseError{File: r.name, Row: r.line, Column: column, Err: err}
+	}
+}
+
+//String the trimmed value of the column, empty when the column is absent
+func (r *csvReader) String(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.row) {
+		return ""
+	}
+	return strings.TrimSpace(r.row[i])
+}
+
+//Int the value of the column as an int, 0 when empty
+func (r *csvReader) Int(column string) int {
+	value := r.String(column)
+	if value == "" {
+		return 0
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		r.fail(column, err)
+	}
+	return i
+}
+
+//Uint32 the value of the column as an uint32, 0 when empty
+func (r *csvReader) Uint32(column string) uint32 {
+	if v := r.OptionalUint32(column); v != nil {
+		return *v
+	}
+	return 0
+}
+
+//OptionalUint32 the value of the column as an uint32, nil when empty
+func (r *csvReader) OptionalUint32(column string) *uint32 {
+	value := r.String(column)
+	if value == "" {
+		return nil
+	}
+	i, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		r.fail(column, err)
+		return nil
+	}
+	u := uint32(i)
+	return &u
+}
+
+//Float the value of the column as a float64, 0 when empty
+func (r *csvReader) Float(column string) float64 {
+	if v := r.OptionalFloat(column); v != nil {
+		return *v
+	}
+	return 0
+}
+
+//OptionalFloat the value of the column as a float64, nil when empty
+func (r *csvReader) OptionalFloat(column string) *float64 {
+	value := r.String(column)
+	if value == "" {
+		return nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.fail(column, err)
+		return nil
+	}
+	return &f
+}
+
+//Date the value of the column as a gtfs YYYYMMDD date, the zero time when empty
+func (r *csvReader) Date(column string) time.Time {
+	value := r.String(column)
+	if value == "" {
+		return time.Time{}
+	}
+	date, err := parseGTFSDate(value)
+	if err != nil {
+		r.fail(column, err)
+	}
+	return date
+}
+
+//ServiceTime the value of the column as a gtfs HH:MM:SS time
+func (r *csvReader) ServiceTime(column string) ServiceTime {
+	t, err := parseServiceTime(r.String(column))
+	if err != nil {
+		r.fail(column, err)
+	}
+	return t
+}
@@ -0,0 +1,68 @@
+// +build !integration
+
+package beater
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCSVReaderMapsColumnsByHeader(t *testing.T) {
+	data := utf8BOM + "stop_lon,stop_id,extra,stop_lat,wheelchair_boarding\n" +
+		"-98.49,100,ignored,29.42,\n" +
+		"-98.50,200,ignored,29.43,2\n"
+	r, err := newCSVReader("stops.txt", strings.NewReader(data), "stop_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Static{Stops: map[string]Stop{}}
+	for {
+		ok, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			break
+		}
+		s.addStop(r)
+		if err := r.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if stop := s.Stops["100"]; stop.Position.Lat != 29.42 || stop.Position.Long != -98.49 || stop.WheelcharBoarding != 0 {
+		t.Errorf("unexpected stop %+v", stop)
+	}
+	if stop := s.Stops["200"]; stop.WheelcharBoarding != 2 || stop.Name != "" {
+		t.Errorf("unexpected stop %+v", stop)
+	}
+}
+
+func TestCSVReaderErrors(t *testing.T) {
+	if _, err := newCSVReader("stops.txt", strings.NewReader("stop_name\nMain St\n"), "stop_id"); err == nil ||
+		err.Error() != "stops.txt:1: column stop_id: missing required column" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	r, err := newCSVReader("stops.txt", strings.NewReader("stop_id,stop_lat\n100,29.42\n200,north\n,29.44\n"), "stop_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"",
+		"stops.txt:3: column stop_lat: strconv.ParseFloat: parsing \"north\": invalid syntax",
+		"stops.txt:4: column stop_id: missing required value",
+	}
+	for _, want := range expected {
+		if ok, err := r.Next(); !ok || err != nil {
+			t.Fatalf("unexpected end of file %v", err)
+		}
+		r.Float("stop_lat")
+		got := ""
+		if r.Err() != nil {
+			got = r.Err().Error()
+		}
+		if got != want {
+			t.Errorf("got error %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+package beater
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//stalledPolls how many periods the header timestamp may stay the same before the feed is reported as stalled
+const stalledPolls = 3
+
+//Feed a single realtime feed polled on its own schedule
+type Feed struct {
+	config        config.FeedConfig
+	client        *http.Client
+	auth          *authenticator
+	files         *fileSource
+	archive       *archiver
+	clock         func() time.Time
+	lastUpdated   time.Time
+	lastModified  string
+	etag          string
+	lastTimestamp uint64
+	lastAdvanced  time.Time
+	stalled       bool
+	breaker       *circuitBreaker
+	retryAt       time.Time
+	static        *StaticBundle
+	state         *feedState
+	tracks        map[string]*vehicleTrack
+	progress      map[string]shapeProgress
+	visits        map[string]*vehicleVisits
+	activity      map[string]*vehicleActivity
+	vehiclesKnown bool
+	metrics       *feedMetrics
+	stats         feedStats
+}
+
+//NewFeed creates a feed for the given configuration and static gtfs bundle
+func NewFeed(c config.FeedConfig, static *StaticBundle) *Feed {
+	static.feeds = append(static.feeds, c.Name)
+	client := newFeedClient(c)
+	f := &Feed{
+		config:   c,
+		client:   client,
+		auth:     newAuthenticator(c.AuthConfig, client),
+		breaker:  newCircuitBreaker(c.Retry),
+		static:   static,
+		state:    newFeedState(),
+		tracks:   map[string]*vehicleTrack{},
+		progress: map[string]shapeProgress{},
+		visits:   map[string]*vehicleVisits{},
+		activity: map[string]*vehicleActivity{},
+		metrics:  getFeedMetrics(c.Name),
+		clock:    time.Now,
+	}
+	if path := feedPath(c); path != "" {
+		f.files = newFileSource(path)
+	}
+	if c.Archive.Path != "" {
+		f.archive = newArchiver(c.Archive, c.Name)
+	}
+	return f
+}
+
+//Static the current version of the feed's static gtfs bundle
+func (f *Feed) Static() *Static {
+	return f.static.Get()
+}
+
+//Name the configured name of the feed
+func (f *Feed) Name() string {
+	return f.config.Name
+}
+
+//now the time events are processed at, which is the original time of the messages when replayed
+func (f *Feed) now() time.Time {
+	return f.clock()
+}
+
+//GetGtfsFeed gathers the feed message, nil when the feed has not been updated
+func (f *Feed) GetGtfsFeed() (*transit_realtime.FeedMessage, error) {
+	req, err := http.NewRequest("GET", f.config.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.auth.Authorize(req); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	if f.etag != "" {
+		req.Header.Set("If-None-Match", f.etag)
+	}
+	if f.lastModified != "" {
+		req.Header.Set("If-Modified-Since", f.lastModified)
+	}
+	start := time.Now()
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, f.auth.Redact(err)
+	}
+	defer resp.Body.Close()
+	logp.Debug("gtfsbeat", "Received gtfs feed %s: %s", f.Name(), resp.Status)
+	if resp.StatusCode == http.StatusUnauthorized {
+		// The access token may have been revoked before its expiry
+		f.auth.Invalidate()
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		f.recordResponse(resp.StatusCode, time.Since(start), 0)
+		logp.Debug("gtfsbeat", "Feed %s has not been modified", f.Name())
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		f.recordResponse(resp.StatusCode, time.Since(start), 0)
+		return nil, newHTTPError(resp, time.Now())
+	}
+	body, err := readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	f.recordResponse(resp.StatusCode, time.Since(start), len(body))
+	feed := transit_realtime.FeedMessage{}
+	lastModifiedHeader := resp.Header.Get("Last-Modified")
+	var lastModified time.Time
+	if lastModifiedHeader != "" {
+		// Not every server honors conditional requests
+		if parsed, err := http.ParseTime(lastModifiedHeader); err == nil {
+			if !parsed.After(f.lastUpdated) {
+				logp.Info("Data has not been updated since %s. Last update %s", parsed, f.lastUpdated)
+				return nil, nil
+			}
+			lastModified = parsed
+		}
+	}
+	if err := proto.Unmarshal(body, &feed); err != nil {
+		f.recordParseError()
+		logp.Error(err)
+		return nil, err
+	}
+	// Only a message that parsed may be answered with 304 Not Modified from now on
+	f.etag = resp.Header.Get("ETag")
+	f.lastModified = lastModifiedHeader
+	if !lastModified.IsZero() {
+		f.lastUpdated = lastModified
+	}
+	if f.archive != nil {
+		f.archiveMessage(body, feed.GetHeader())
+	}
+	return &feed, nil
+}
+
+//archiveMessage archives the raw body of a message by its header timestamp, or
+//the time it was received when it has none
+func (f *Feed) archiveMessage(body []byte, header *transit_realtime.FeedHeader) {
+	timestamp := time.Now()
+	if header.GetTimestamp() != 0 {
+		timestamp = time.Unix(int64(header.GetTimestamp()), 0)
+	}
+	if err := f.archive.Write(body, timestamp); err != nil {
+		logp.Err("Error archiving feed %s: %v", f.Name(), err)
+	}
+}
+
+//fetch gets the feed message, retrying failed requests with backoff. A
+//Retry-After beyond the maximum backoff holds off polling until it elapsed.
+func (f *Feed) fetch(done <-chan struct{}) (*transit_realtime.FeedMessage, error) {
+	for attempt := 0; ; attempt++ {
+		message, err := f.GetGtfsFeed()
+		if err == nil || !retryable(err) {
+			return message, err
+		}
+		delay := backoff(f.config.Retry, attempt)
+		if httpErr, ok := err.(*httpError); ok && httpErr.RetryAfter > 0 {
+			if attempt >= f.config.Retry.MaxRetries || httpErr.RetryAfter > f.config.Retry.MaxBackoff {
+				f.retryAt = time.Now().Add(httpErr.RetryAfter)
+				return nil, err
+			}
+			delay = httpErr.RetryAfter
+		}
+		if attempt >= f.config.Retry.MaxRetries {
+			return nil, err
+		}
+		logp.Warn("Retrying feed %s in %s after error: %v", f.Name(), delay, err)
+		select {
+		case <-done:
+			return nil, err
+		case <-time.After(delay):
+		}
+	}
+}
+
+//poll fetches and processes the feed unless its circuit is open, followed by
+//a health event whenever the health of the feed changed
+func (f *Feed) poll(done <-chan struct{}) []beat.Event {
+	now := time.Now()
+	if now.Before(f.retryAt) {
+		logp.Debug("gtfsbeat", "Feed %s asked to retry after %s", f.Name(), f.retryAt)
+		return nil
+	}
+	allowed, changed := f.breaker.Allow(now)
+	if !allowed {
+		logp.Debug("gtfsbeat", "Circuit of feed %s is open", f.Name())
+		return nil
+	}
+	events := []beat.Event{}
+	var messages []*transit_realtime.FeedMessage
+	var err error
+	if f.files != nil {
+		messages, err = f.readFiles()
+	} else {
+		var message *transit_realtime.FeedMessage
+		if message, err = f.fetch(done); message != nil {
+			messages = append(messages, message)
+		}
+	}
+	f.recordPoll(err)
+	if err != nil {
+		logp.Err("Error fetching feed %s: %v", f.Name(), err)
+		if f.breaker.Failure(err, time.Now()) {
+			changed = true
+			if f.breaker.state == healthStateCircuitOpen {
+				logp.Warn("Feed %s failed %d times, pausing it for %s", f.Name(), f.breaker.failures, f.breaker.timeout)
+			}
+		}
+	} else if f.breaker.Success() {
+		changed = true
+		logp.Info("Feed %s is healthy again", f.Name())
+	}
+	// Messages read before an error are processed all the same
+	for _, message := range messages {
+		events = append(events, f.ProcessFeed(message)...)
+	}
+	if changed {
+		events = append(events, f.healthEvent(time.Now()))
+	}
+	return events
+}
+
+//ProcessFeed applies the feed message to the current state of the feed and
+//transforms the resulting entities, followed by a deletion event for every
+//entity that was removed. Nothing is published when the header timestamp
+//has not advanced since the previous message.
+func (f *Feed) ProcessFeed(message *transit_realtime.FeedMessage) []beat.Event {
+	now := f.now()
+	if !f.checkHeader(message.GetHeader(), now) {
+		return nil
+	}
+	deleted := f.state.Apply(message)
+	f.recordMessage(message.GetHeader(), now)
+	entities := f.state.Entities()
+	events := f.TransformEntities(entities)
+	f.pruneVehicles(now)
+	events = append(events, f.expireStopVisits(now)...)
+	events = append(events, f.HeadwayEvents(entities)...)
+	for _, entity := range deleted {
+		events = append(events, f.deletionEvent(entity))
+	}
+	events = append(events, f.LifecycleEvents(entities, now)...)
+	for i := range events {
+		addFeedHeader(message.GetHeader(), now, &events[i])
+	}
+	return events
+}
+
+//checkHeader whether the message has to be processed, which is when the
+//producer published a new message since the previous one or the message is a
+//differential update, reporting the feed as stalled when its timestamp stops
+//changing
+func (f *Feed) checkHeader(header *transit_realtime.FeedHeader, now time.Time) bool {
+	timestamp := header.GetTimestamp()
+	if timestamp == 0 {
+		// Without a timestamp there is no telling whether the feed changed
+		return true
+	}
+	f.metrics.headerTimestamp.Set(int64(timestamp))
+	if timestamp != f.lastTimestamp || f.lastAdvanced.IsZero() {
+		if timestamp < f.lastTimestamp {
+			// The producer restarted or corrected its clock
+			logp.Info("Feed %s header timestamp went back from %s to %s", f.Name(), time.Unix(int64(f.lastTimestamp), 0).UTC(), time.Unix(int64(timestamp), 0).UTC())
+		}
+		if f.stalled {
+			logp.Info("Feed %s is advancing again, header timestamp %s", f.Name(), time.Unix(int64(timestamp), 0).UTC())
+			f.stalled = false
+			f.metrics.stalled.Set(0)
+		}
+		f.lastTimestamp = timestamp
+		f.lastAdvanced = now
+		return true
+	}
+	f.metrics.unchanged.Inc()
+	logp.Debug("gtfsbeat", "Feed %s has not changed since %s", f.Name(), time.Unix(int64(f.lastTimestamp), 0).UTC())
+	if !f.stalled && now.Sub(f.lastAdvanced) >= stalledPolls*f.config.Period {
+		logp.Warn("Feed %s has not advanced since %s, header timestamp %s", f.Name(), f.lastAdvanced.UTC(), time.Unix(int64(f.lastTimestamp), 0).UTC())
+		f.stalled = true
+		f.metrics.stalled.Set(1)
+	}
+	// Differential updates are never repeated, skipping one would lose its changes
+	return header.GetIncrementality() == transit_realtime.FeedHeader_DIFFERENTIAL
+}
+
+//addFeedHeader adds the producer timestamp of the message, its age and the gtfs realtime version
+func addFeedHeader(header *transit_realtime.FeedHeader, now time.Time, e *beat.Event) {
+	if header == nil {
+		return
+	}
+	addStringIfNotNull("feed.gtfs_realtime_version", header.GtfsRealtimeVersion, e)
+	if header.GetTimestamp() != 0 {
+		timestamp := time.Unix(int64(header.GetTimestamp()), 0).UTC()
+		e.PutValue("feed.timestamp", timestamp)
+		e.PutValue("feed.age_seconds", int64(now.Sub(timestamp)/time.Second))
+	}
+}
+
+func (f *Feed) deletionEvent(entity *transit_realtime.FeedEntity) beat.Event {
+	event := beat.Event{
+		Timestamp: f.now(),
+		Fields:    common.MapStr{},
+	}
+	event.PutValue("type", "deletion")
+	event.PutValue("entity_id", entity.GetId())
+	event.PutValue("feed.name", f.Name())
+	switch {
+	case entity.Vehicle != nil:
+		event.PutValue("entity_type", "vehicle")
+		addTrip(entity.Vehicle.Trip, &event)
+		addVehicleDescriptors(entity.Vehicle.Vehicle, &event)
+	case entity.TripUpdate != nil:
+		event.PutValue("entity_type", "trip_update")
+		addTrip(entity.TripUpdate.Trip, &event)
+		addVehicleDescriptors(entity.TripUpdate.Vehicle, &event)
+	case entity.Alert != nil:
+		event.PutValue("entity_type", "alert")
+	}
+	return event
+}
+
+//TransformEntities transforms feed entities into events tagged with the feed name
+func (f *Feed) TransformEntities(feedentity []*transit_realtime.FeedEntity) []beat.Event {
+	events := []beat.Event{}
+	for _, entity := range feedentity {
+		entityEvents := []beat.Event{}
+		if entity.Vehicle != nil {
+			entityEvents = append(entityEvents, f.TransformVehicle(entity.Vehicle))
+			entityEvents = append(entityEvents, f.TrackStopVisit(entity.Vehicle)...)
+		}
+		if entity.TripUpdate != nil {
+			entityEvents = append(entityEvents, f.DenormalizeTripUpdate(entity.TripUpdate)...)
+		}
+		if entity.Alert != nil {
+			entityEvents = append(entityEvents, f.DenormalizeAlert(entity.GetId(), entity.Alert)...)
+		}
+		for i := range entityEvents {
+			entityEvents[i].PutValue("entity_id", entity.GetId())
+			entityEvents[i].PutValue("feed.name", f.Name())
+		}
+		events = append(events, entityEvents...)
+	}
+	return events
+}
+
+//Run polls the feed, or reads the new files of a local feed, every period and
+//publishes its events, along with a health event every health period, until
+//done is closed
+func (f *Feed) Run(client beat.Client, done <-chan struct{}) {
+	logp.Info("Polling feed %s every %s", f.Name(), f.config.Period)
+	ticker := time.NewTicker(f.config.Period)
+	defer ticker.Stop()
+	var health <-chan time.Time
+	if f.config.HealthPeriod > 0 {
+		healthTicker := time.NewTicker(f.config.HealthPeriod)
+		defer healthTicker.Stop()
+		health = healthTicker.C
+	}
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-health:
+			client.Publish(f.healthEvent(now))
+			f.stats.resetCounters()
+			continue
+		case <-ticker.C:
+		}
+		events := f.poll(done)
+		if len(events) > 0 {
+			client.PublishAll(events)
+		}
+		logp.Info("Events sent for feed %s: %d", f.Name(), len(events))
+	}
+}
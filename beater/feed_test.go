@@ -0,0 +1,144 @@
+// +build !integration
+
+package beater
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestProcessFeedSkipsUnchangedTimestamp(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	message := feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"))
+	message.Header.Timestamp = proto.Uint64(uint64(time.Now().Add(-30 * time.Second).Unix()))
+	events := f.ProcessFeed(message)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	if version, _ := events[0].GetValue("feed.gtfs_realtime_version"); version != "2.0" {
+		t.Errorf("feed.gtfs_realtime_version = %v", version)
+	}
+	if timestamp, _ := events[0].GetValue("feed.timestamp"); !timestamp.(time.Time).Equal(time.Unix(int64(message.Header.GetTimestamp()), 0)) {
+		t.Errorf("feed.timestamp = %v", timestamp)
+	}
+	if age, _ := events[0].GetValue("feed.age_seconds"); age.(int64) < 30 {
+		t.Errorf("feed.age_seconds = %v", age)
+	}
+	if events := f.ProcessFeed(message); len(events) != 0 {
+		t.Errorf("expected no events for an unchanged feed, got %d", len(events))
+	}
+	message.Header.Timestamp = proto.Uint64(message.Header.GetTimestamp() + 30)
+	if events := f.ProcessFeed(message); len(events) != 1 {
+		t.Errorf("expected 1 event once the feed advanced, got %d", len(events))
+	}
+}
+
+func TestCheckHeaderReportsStalledFeed(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "stalled", Period: time.Minute}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	header := &transit_realtime.FeedHeader{Timestamp: proto.Uint64(1000)}
+	now := time.Unix(1000, 0)
+	if !f.checkHeader(header, now) {
+		t.Error("expected the first message to be new")
+	}
+	if f.checkHeader(header, now.Add(time.Minute)) || f.stalled {
+		t.Error("expected an unchanged feed that is not stalled yet")
+	}
+	if f.checkHeader(header, now.Add(3*time.Minute)) || !f.stalled {
+		t.Error("expected the feed to be stalled")
+	}
+	header.Timestamp = proto.Uint64(1200)
+	if !f.checkHeader(header, now.Add(4*time.Minute)) || f.stalled {
+		t.Error("expected the feed to have recovered")
+	}
+	// A producer restart may set its timestamp back
+	header.Timestamp = proto.Uint64(900)
+	if !f.checkHeader(header, now.Add(5*time.Minute)) {
+		t.Error("expected a timestamp that went back to be new")
+	}
+	header.Incrementality = transit_realtime.FeedHeader_DIFFERENTIAL.Enum()
+	if !f.checkHeader(header, now.Add(6*time.Minute)) {
+		t.Error("expected a differential update with the same timestamp to be applied")
+	}
+}
+
+func TestGetGtfsFeedConditionalAndCompressed(t *testing.T) {
+	message := feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"))
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	writer.Write(data)
+	writer.Close()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(compressed.Bytes())
+	}))
+	defer server.Close()
+
+	f := NewFeed(config.FeedConfig{Name: "test", URL: server.URL, Timeout: time.Second, ConnectTimeout: time.Second}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	feed, err := f.GetGtfsFeed()
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if feed == nil || len(feed.Entity) != 1 || feed.Entity[0].GetId() != "1" {
+		t.Fatalf("unexpected feed %v", feed)
+	}
+	feed, err = f.GetGtfsFeed()
+	if err != nil || feed != nil {
+		t.Errorf("expected an unmodified feed, got %v, %v", feed, err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestGetGtfsFeedRetriesCorruptMessage(t *testing.T) {
+	data, err := proto.Marshal(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupt := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` || r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		if corrupt {
+			// Truncated by the producer
+			w.Write(data[:len(data)-3])
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	f := NewFeed(config.FeedConfig{Name: "test", URL: server.URL, Timeout: time.Second, ConnectTimeout: time.Second}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	if _, err := f.GetGtfsFeed(); err == nil {
+		t.Fatal("expected the corrupt message to fail")
+	}
+	corrupt = false
+	feed, err := f.GetGtfsFeed()
+	if err != nil || feed == nil || len(feed.Entity) != 1 {
+		t.Errorf("expected the message to be requested again, got %v, %v", feed, err)
+	}
+}
@@ -0,0 +1,151 @@
+package beater
+
+import (
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//feedFileExtension the extension of the feed messages read from a directory
+const feedFileExtension = ".pb"
+
+//feedPath the local file or directory the feed is read from, empty when it is requested over http
+func feedPath(c config.FeedConfig) string {
+	if c.Path != "" {
+		return c.Path
+	}
+	if strings.HasPrefix(c.URL, "file://") {
+		if u, err := url.Parse(c.URL); err == nil {
+			return u.Path
+		}
+	}
+	return ""
+}
+
+//fileSource reads feed messages from a local file, or from every new or
+//rewritten .pb file of a local directory in the order of their header
+//timestamps. Producers should write files elsewhere and move them in once
+//complete.
+type fileSource struct {
+	path string
+	seen map[string]time.Time
+}
+
+func newFileSource(path string) *fileSource {
+	return &fileSource{path: path, seen: map[string]time.Time{}}
+}
+
+//feedFile a file holding a feed message
+type feedFile struct {
+	path    string
+	modTime time.Time
+}
+
+//feedFileMessage a feed message read from a file, and the time it was
+//produced: the timestamp of its header, or else when the file was written
+type feedFileMessage struct {
+	message  *transit_realtime.FeedMessage
+	path     string
+	produced time.Time
+}
+
+//Next reads the files that changed since the previous call, oldest message
+//first. Files that are not feed messages are skipped, reported through
+//parseError.
+func (s *fileSource) Next(parseError func(path string, err error)) ([]*transit_realtime.FeedMessage, error) {
+	files, err := s.changedFiles()
+	if err != nil {
+		return nil, err
+	}
+	read := []feedFileMessage{}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file.path)
+		if err != nil {
+			return sortFeedFileMessages(read), err
+		}
+		s.seen[file.path] = file.modTime
+		message := &transit_realtime.FeedMessage{}
+		if err := proto.Unmarshal(data, message); err != nil {
+			parseError(file.path, err)
+			continue
+		}
+		produced := file.modTime
+		if message.GetHeader().Timestamp != nil {
+			produced = time.Unix(int64(message.GetHeader().GetTimestamp()), 0)
+		}
+		read = append(read, feedFileMessage{message: message, path: file.path, produced: produced})
+	}
+	return sortFeedFileMessages(read), nil
+}
+
+//sortFeedFileMessages the messages in the order they were produced, as files
+//copied or moved into the directory do not keep their original times
+func sortFeedFileMessages(read []feedFileMessage) []*transit_realtime.FeedMessage {
+	sort.Slice(read, func(i, j int) bool {
+		if read[i].produced.Equal(read[j].produced) {
+			return read[i].path < read[j].path
+		}
+		return read[i].produced.Before(read[j].produced)
+	})
+	messages := make([]*transit_realtime.FeedMessage, len(read))
+	for i, file := range read {
+		messages[i] = file.message
+	}
+	return messages
+}
+
+//changedFiles the files not read yet or modified since
+func (s *fileSource) changedFiles() ([]feedFile, error) {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+	candidates := []feedFile{}
+	if !info.IsDir() {
+		candidates = append(candidates, feedFile{path: s.path, modTime: info.ModTime()})
+	} else {
+		entries, err := ioutil.ReadDir(s.path)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") || filepath.Ext(entry.Name()) != feedFileExtension {
+				continue
+			}
+			candidates = append(candidates, feedFile{path: filepath.Join(s.path, entry.Name()), modTime: entry.ModTime()})
+		}
+	}
+	present := make(map[string]bool, len(candidates))
+	changed := []feedFile{}
+	for _, file := range candidates {
+		present[file.path] = true
+		if modTime, ok := s.seen[file.path]; !ok || !modTime.Equal(file.modTime) {
+			changed = append(changed, file)
+		}
+	}
+	// Forget removed files so the map does not grow with every file ever read
+	for path := range s.seen {
+		if !present[path] {
+			delete(s.seen, path)
+		}
+	}
+	return changed, nil
+}
+
+//readFiles reads the new feed messages of a local feed
+func (f *Feed) readFiles() ([]*transit_realtime.FeedMessage, error) {
+	return f.files.Next(func(path string, err error) {
+		f.recordParseError()
+		logp.Err("Error parsing feed %s file %s: %v", f.Name(), path, err)
+	})
+}
@@ -0,0 +1,120 @@
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func writeFeedFile(t *testing.T, path string, timestamp uint64, modTime time.Time) {
+	message := feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"))
+	message.Header.Timestamp = proto.Uint64(timestamp)
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFileSourceReadsNewFilesInOrder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gtfsbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Now().Truncate(time.Second)
+	writeFeedFile(t, filepath.Join(dir, "b.pb"), 200, now.Add(-time.Minute))
+	writeFeedFile(t, filepath.Join(dir, "a.pb"), 100, now.Add(-2*time.Minute))
+	ioutil.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0644)
+
+	source := newFileSource(dir)
+	parseErrors := 0
+	countErrors := func(string, error) { parseErrors++ }
+	messages, err := source.Next(countErrors)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(messages) != 2 || messages[0].Header.GetTimestamp() != 100 || messages[1].Header.GetTimestamp() != 200 {
+		t.Fatalf("expected the files in the order they were written, got %v", messages)
+	}
+	if messages, _ := source.Next(countErrors); len(messages) != 0 {
+		t.Errorf("expected no new files, got %d", len(messages))
+	}
+	writeFeedFile(t, filepath.Join(dir, "c.pb"), 300, now)
+	ioutil.WriteFile(filepath.Join(dir, "d.pb"), []byte("partial"), 0644)
+	messages, _ = source.Next(countErrors)
+	if len(messages) != 1 || messages[0].Header.GetTimestamp() != 300 || parseErrors != 1 {
+		t.Errorf("expected the new file and a parse error, got %d messages and %d errors", len(messages), parseErrors)
+	}
+}
+
+func TestFileSourceOrdersFilesByHeaderTimestamp(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gtfsbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Now().Truncate(time.Second)
+	// Copied in the reverse order they were produced
+	writeFeedFile(t, filepath.Join(dir, "a.pb"), 300, now.Add(-3*time.Minute))
+	writeFeedFile(t, filepath.Join(dir, "b.pb"), 200, now.Add(-2*time.Minute))
+	writeFeedFile(t, filepath.Join(dir, "c.pb"), 100, now.Add(-time.Minute))
+	// Without a timestamp the file is ordered by when it was written
+	message := feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"))
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "d.pb")
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, time.Unix(150, 0), time.Unix(150, 0)); err != nil {
+		t.Fatal(err)
+	}
+
+	messages, err := newFileSource(dir).Next(func(string, error) {})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	want := []uint64{100, 0, 200, 300}
+	if len(messages) != len(want) {
+		t.Fatalf("expected %d messages, got %d", len(want), len(messages))
+	}
+	for i, timestamp := range want {
+		if got := messages[i].Header.GetTimestamp(); got != timestamp {
+			t.Errorf("message %d has timestamp %d, want %d", i, got, timestamp)
+		}
+	}
+}
+
+func TestPollReadsFileURL(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gtfsbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "vehicles.pb")
+	writeFeedFile(t, path, 100, time.Now())
+	f := NewFeed(config.FeedConfig{Name: "test", URL: "file://" + path}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	if events := f.poll(make(chan struct{})); len(events) != 1 {
+		t.Errorf("expected 1 event, got %d", len(events))
+	}
+	if events := f.poll(make(chan struct{})); len(events) != 0 {
+		t.Errorf("expected an unchanged file to be skipped, got %d events", len(events))
+	}
+}
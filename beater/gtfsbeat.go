@@ -1,21 +1,13 @@
 package beater
 
 import (
-	"encoding/csv"
-	"errors"
 	"fmt"
-	"hash/fnv"
-	"io"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"strconv"
+	"sync"
 	"time"
 
 	"github.com/elastic/beats/libbeat/beat"
 	"github.com/elastic/beats/libbeat/common"
 	"github.com/elastic/beats/libbeat/logp"
-	"github.com/golang/protobuf/proto"
 
 	"github.com/benwtrent/gtfsbeat/config"
 	"github.com/benwtrent/gtfsbeat/transit_realtime"
@@ -23,8 +15,8 @@ import (
 
 //TimeRange simple timerange
 type TimeRange struct {
-	Start *uint64 `json:"gte,omitempty"`
-	End   *uint64 `json:"lte,omitempty"`
+	Start *time.Time `json:"gte,omitempty"`
+	End   *time.Time `json:"lte,omitempty"`
 }
 
 //Trip collection of trip information
@@ -118,11 +110,11 @@ type TripUpdate struct {
 
 // Gtfsbeat configuration.
 type Gtfsbeat struct {
-	done        chan struct{}
-	config      config.Config
-	client      beat.Client
-	lastUpdated time.Time
-	Stops       map[string]Stop
+	done    chan struct{}
+	stop    sync.Once
+	config  config.Config
+	Feeds   []*Feed
+	bundles []*StaticBundle
 }
 
 func addStringIfNotEmpty(key string, val string, e *beat.Event) {
@@ -143,6 +135,12 @@ func addUint32IfNotNull(key string, val *uint32, e *beat.Event) {
 	}
 }
 
+func addInt32IfNotNull(key string, val *int32, e *beat.Event) {
+	if val != nil && e != nil {
+		e.PutValue(key, *val)
+	}
+}
+
 func addFloat32IfNotNull(key string, val *float32, e *beat.Event) {
 	if val != nil && e != nil {
 		e.PutValue(key, *val)
@@ -166,7 +164,7 @@ func addStop(stop Stop, e *beat.Event) {
 		addStringIfNotEmpty("stop.timezone", stop.Timezone, e)
 		addStringIfNotEmpty("stop.url", stop.URL, e)
 		if stop.Position.Lat != 0 {
-			e.PutValue("stop.pos", fmt.Sprint("%f,%f", stop.Position.Lat, stop.Position.Long))
+			e.PutValue("stop.pos", fmt.Sprintf("%f,%f", stop.Position.Lat, stop.Position.Long))
 		}
 		e.PutValue("stop.wheelchair_boarding", stop.WheelcharBoarding)
 		addStringIfNotEmpty("stop.zone_id", stop.ZoneID, e)
@@ -184,26 +182,16 @@ func addTrip(trip *transit_realtime.TripDescriptor, e *beat.Event) {
 		addStringIfNotNull("trip.route_id", trip.RouteId, e)
 		addUint32IfNotNull("trip.direction_id", trip.DirectionId, e)
 		e.PutValue("trip.state", trip.GetScheduleRelationship().String())
-		addStringIfNotNull("trip.id", trip.TripId, e)
-		if trip.StartTime != nil {
-			date := time.Now()
-			if trip.StartDate != nil {
-				var err error
-				date, err = time.Parse("20060102", *trip.StartDate)
-				if err != nil {
-					logp.Error(err)
-				} else {
-					date = time.Now()
-				}
-			}
-			year, month, day := date.Date()
-			startTime, err := time.Parse("20060102 15:04:05", string(year)+string(month)+string(day)+" "+*trip.StartTime)
-			if err != nil {
-				logp.Error(err)
-			} else {
-				e.PutValue("trip.start_time", startTime)
-			}
+	}
+}
+
+func addStopTimeEvent(prefix string, stopTimeEvent *transit_realtime.TripUpdate_StopTimeEvent, e *beat.Event) {
+	if stopTimeEvent != nil && e != nil {
+		addInt32IfNotNull(prefix+".delay", stopTimeEvent.Delay, e)
+		if stopTimeEvent.Time != nil {
+			e.PutValue(prefix+".time", time.Unix(*stopTimeEvent.Time, 0).UTC())
 		}
+		addInt32IfNotNull(prefix+".uncertainty", stopTimeEvent.Uncertainty, e)
 	}
 }
 
@@ -215,65 +203,105 @@ func addVehicleDescriptors(vehicleDescriptors *transit_realtime.VehicleDescripto
 	}
 }
 
-//DenormalizeAlert denormalizes a gtfs alert
-func DenormalizeAlert(alert *transit_realtime.Alert) []beat.Event {
-	events := make([]beat.Event, len(alert.InformedEntity))
-	timeRange := make([]TimeRange, len(alert.ActivePeriod))
-	for i, t := range alert.ActivePeriod {
-		timeRange[i] = TimeRange{t.Start, t.End}
-	}
-
-	for i, entity := range alert.GetInformedEntity() {
-		event := beat.Event{}
-		id := ""
-		if entity.AgencyId != nil {
-			id += *entity.AgencyId
+//DenormalizeAlert denormalizes a gtfs alert into one event per informed entity,
+//identified by the feed entity of the alert so that every poll updates them
+func (f *Feed) DenormalizeAlert(entityID string, alert *transit_realtime.Alert) []beat.Event {
+	timeRange := make([]TimeRange, len(alert.GetActivePeriod()))
+	for i, t := range alert.GetActivePeriod() {
+		if t.Start != nil {
+			start := time.Unix(int64(*t.Start), 0).UTC()
+			timeRange[i].Start = &start
 		}
-		if entity.RouteId != nil {
-			id += *entity.RouteId
+		if t.End != nil {
+			end := time.Unix(int64(*t.End), 0).UTC()
+			timeRange[i].End = &end
 		}
-		if entity.StopId != nil {
-			id += *entity.StopId
-		}
-		if alert.DescriptionText != nil {
-			h := fnv.New32a()
-			h.Write([]byte(*(*alert.DescriptionText).GetTranslation()[0].Text))
-			id += string(h.Sum32())
+	}
+	url := translate(alert.Url, f.config.Language)
+	header := translate(alert.HeaderText, f.config.Language)
+	description := translate(alert.DescriptionText, f.config.Language)
+
+	informedEntities := alert.GetInformedEntity()
+	if len(informedEntities) == 0 {
+		// An alert must inform at least one entity, but publish it anyway rather than dropping it
+		informedEntities = []*transit_realtime.EntitySelector{{}}
+	}
+	events := make([]beat.Event, 0, len(informedEntities))
+	for i, entity := range informedEntities {
+		event := beat.Event{
+			Timestamp: f.now(),
+			Fields:    common.MapStr{},
 		}
-		event.SetID(id)
+		event.PutValue("type", "alert")
 		event.PutValue("alert_cause", alert.GetCause().String())
 		event.PutValue("alert_effect", alert.GetEffect().String())
 		if len(timeRange) > 0 {
 			event.PutValue("active_period", timeRange)
 		}
-		if alert.Url != nil {
-			event.PutValue("url", alert.Url.GetTranslation()[0])
+		addStringIfNotNull("url", url, &event)
+		addStringIfNotNull("header", header, &event)
+		addStringIfNotNull("description", description, &event)
+		addStringIfNotNull("agency_id", entity.AgencyId, &event)
+		addStringIfNotNull("route_id", entity.RouteId, &event)
+		if entity.RouteType != nil {
+			event.PutValue("route_type", *entity.RouteType)
 		}
-		if alert.DescriptionText != nil {
-			event.PutValue("description", alert.DescriptionText.GetTranslation()[0])
+		if entity.StopId != nil {
+			f.addStopByID(*entity.StopId, &event)
 		}
-		if alert.HeaderText != nil {
-			event.PutValue("header", alert.HeaderText.GetTranslation()[0])
+		f.addScheduledTrip(entity.Trip, &event)
+		if entity.RouteId != nil {
+			f.addRoute(*entity.RouteId, &event)
+		} else {
+			f.addRoute(f.routeID(entity.Trip), &event)
 		}
-		addStringIfNotNull("agency_id", entity.AgencyId, &event)
-		addStringIfNotNull("route_id", entity.AgencyId, &event)
-		addStringIfNotNull("route_type", entity.AgencyId, &event)
-		addStringIfNotNull("stop", entity.AgencyId, &event)
-		addTrip(entity.Trip, &event)
-		events[i] = event
+		if entity.AgencyId != nil {
+			if agency, ok := f.Static().Agencies[*entity.AgencyId]; ok {
+				addStringIfNotEmpty("agency.name", agency.Name, &event)
+			}
+		}
+		if entityID != "" {
+			event.SetID(fmt.Sprintf("%s-%s-%d", f.Name(), entityID, i))
+		}
+		events = append(events, event)
 	}
 	return events
 }
 
+func translate(translatedString *transit_realtime.TranslatedString, language string) *string {
+	if translatedString == nil || len(translatedString.GetTranslation()) == 0 {
+		return nil
+	}
+	var untagged *string
+	for _, translation := range translatedString.GetTranslation() {
+		if language != "" && translation.GetLanguage() == language {
+			return translation.Text
+		}
+		if translation.Language == nil && untagged == nil {
+			untagged = translation.Text
+		}
+	}
+	if untagged != nil {
+		return untagged
+	}
+	return translatedString.GetTranslation()[0].Text
+}
+
 //TransformVehicle transforms a gtfs vehicle position
-func (bt *Gtfsbeat) TransformVehicle(vehicle *transit_realtime.VehiclePosition) beat.Event {
+func (f *Feed) TransformVehicle(vehicle *transit_realtime.VehiclePosition) beat.Event {
 	event := beat.Event{
-		Fields: common.MapStr{},
+		Timestamp: f.now(),
+		Fields:    common.MapStr{},
 	}
+	event.PutValue("type", "vehicle")
 	event.PutValue("congestion", vehicle.GetCongestionLevel().String())
 	event.PutValue("occupancy", vehicle.GetOccupancyStatus().String())
 	event.PutValue("stop_status", vehicle.GetCurrentStatus().String())
-	addTrip(vehicle.Trip, &event)
+	if vehicle.Timestamp != nil {
+		event.Timestamp = time.Unix(int64(*vehicle.Timestamp), 0)
+	}
+	f.addScheduledTrip(vehicle.Trip, &event)
+	f.addRoute(f.routeID(vehicle.Trip), &event)
 	addVehicleDescriptors(vehicle.Vehicle, &event)
 	if vehicle.Position != nil {
 		if vehicle.Position.Latitude != nil && vehicle.Position.Longitude != nil {
@@ -283,87 +311,102 @@ func (bt *Gtfsbeat) TransformVehicle(vehicle *transit_realtime.VehiclePosition)
 		addFloat64IfNotNull("odometer_meters", vehicle.Position.Odometer, &event)
 		addFloat32IfNotNull("speed_meters_per_sec", vehicle.Position.Speed, &event)
 		if vehicle.Position.Speed != nil {
-			event.PutValue("speed_mph", (*vehicle.Position.Speed)*2.2369362921)
+			event.PutValue("speed_mph", (*vehicle.Position.Speed)*metersPerSecToMph)
 		}
 		addFloat32IfNotNull("speed_meters_per_sec", vehicle.Position.Speed, &event)
+		f.addDerivedMotion(vehicle, &event)
+		f.addShapeProgress(vehicle, &event)
 	}
 	addUint32IfNotNull("stop_seq", vehicle.CurrentStopSequence, &event)
-	if vehicle.Timestamp != nil {
-		event.Timestamp = time.Unix(int64(*vehicle.Timestamp), 0)
-	}
 	if vehicle.StopId != nil {
-		logp.Info("stopid %s", *vehicle.StopId)
-		logp.Info("seq %d", *vehicle.CurrentStopSequence)
-		event.PutValue("stop.id", *vehicle.StopId)
-		if stop, ok := bt.Stops[*vehicle.StopId]; ok {
-			if ok {
-				addStop(stop, &event)
-			} else {
-				logp.Warn("Unrecognized stop id %s", *vehicle.StopId)
-			}
+		f.addStopByID(*vehicle.StopId, &event)
+	}
+	if vehicle.CurrentStopSequence != nil || vehicle.StopId != nil {
+		// Only a vehicle stopped at its current stop has arrived, otherwise the
+		// stop is the next one and the vehicle is on its way to it
+		arrival := time.Time{}
+		if vehicle.GetCurrentStatus() == transit_realtime.VehiclePosition_STOPPED_AT {
+			arrival = event.Timestamp
 		}
+		f.addSchedule(vehicle.Trip, vehicle.CurrentStopSequence, vehicle.StopId, arrival, time.Time{}, event.Timestamp, &event)
 	}
+	f.addActivity(vehicle, &event)
 	event.PutValue("stop_status", vehicle.GetCurrentStatus().String())
 	return event
 }
 
-//DenormalizeTripUpdate denormalizes a gtfs trip update
-func DenormalizeTripUpdate(tripupdate *transit_realtime.TripUpdate) beat.Event {
-	event := beat.Event{}
-	addTrip(tripupdate.Trip, &event)
-	addVehicleDescriptors(tripupdate.Vehicle, &event)
-	// TODO fix....
-	return event
-}
-
-func parseStops(fileName string) (map[string]Stop, error) {
-	f, err := os.Open(fileName)
-	if err != nil {
-		logp.Error(err)
-		return nil, err
-	}
-	defer f.Close()
-	csvr := csv.NewReader(f)
-	stops := map[string]Stop{}
-	for {
-		row, err := csvr.Read()
-		if err != nil {
-			if err == io.EOF {
-				err = nil
-			}
-			return stops, err
-		}
-		// Skip the header
-		if row[0] == "stop_id" {
-			continue
+//DenormalizeTripUpdate denormalizes a gtfs trip update into one event per stop time update,
+//or a single trip level event when it has none
+func (f *Feed) DenormalizeTripUpdate(tripupdate *transit_realtime.TripUpdate) []beat.Event {
+	if len(tripupdate.GetStopTimeUpdate()) == 0 {
+		// Cancellations and trip level delays come without stop time updates
+		event := f.tripUpdateEvent(tripupdate)
+		if tripupdate.Delay != nil {
+			event.PutValue("delay_seconds", int64(*tripupdate.Delay))
 		}
-		stop := Stop{
-			ID:            row[0],
-			Code:          row[1],
-			Name:          row[2],
-			Description:   row[3],
-			ZoneID:        row[6],
-			URL:           row[7],
-			LocationType:  row[8],
-			ParentStation: row[9],
-			Timezone:      row[10],
+		return []beat.Event{event}
+	}
+	events := make([]beat.Event, 0, len(tripupdate.GetStopTimeUpdate()))
+	for _, stopTimeUpdate := range tripupdate.GetStopTimeUpdate() {
+		event := f.tripUpdateEvent(tripupdate)
+		addUint32IfNotNull("stop_seq", stopTimeUpdate.StopSequence, &event)
+		if stopTimeUpdate.StopId != nil {
+			f.addStopByID(*stopTimeUpdate.StopId, &event)
 		}
-		if stop.WheelcharBoarding, err = strconv.ParseUint(row[11], 10, 64); err != nil {
-			return nil, err
+		addStopTimeEvent("arrival", stopTimeUpdate.Arrival, &event)
+		addStopTimeEvent("departure", stopTimeUpdate.Departure, &event)
+		var arrival, departure time.Time
+		if stopTimeUpdate.Arrival != nil && stopTimeUpdate.Arrival.Time != nil {
+			arrival = time.Unix(*stopTimeUpdate.Arrival.Time, 0)
 		}
-		var lat float64
-		var lon float64
-		if lat, err = strconv.ParseFloat(row[4], 64); err != nil {
-			return nil, err
+		if stopTimeUpdate.Departure != nil && stopTimeUpdate.Departure.Time != nil {
+			departure = time.Unix(*stopTimeUpdate.Departure.Time, 0)
 		}
-		if lon, err = strconv.ParseFloat(row[5], 64); err != nil {
-			return nil, err
+		reference := event.Timestamp
+		if !arrival.IsZero() {
+			reference = arrival
+		} else if !departure.IsZero() {
+			reference = departure
 		}
-		stop.Position = GeoPoint{
-			Lat:  float32(lat),
-			Long: float32(lon),
+		f.addSchedule(tripupdate.Trip, stopTimeUpdate.StopSequence, stopTimeUpdate.StopId, arrival, departure, reference, &event)
+		if _, err := event.GetValue("delay_seconds"); err != nil {
+			if stopTimeUpdate.Arrival != nil && stopTimeUpdate.Arrival.Delay != nil {
+				event.PutValue("delay_seconds", int64(stopTimeUpdate.GetArrival().GetDelay()))
+			} else if stopTimeUpdate.Departure != nil && stopTimeUpdate.Departure.Delay != nil {
+				event.PutValue("delay_seconds", int64(stopTimeUpdate.GetDeparture().GetDelay()))
+			}
 		}
-		stops[row[0]] = stop
+		event.PutValue("stop_relationship", stopTimeUpdate.GetScheduleRelationship().String())
+		events = append(events, event)
+	}
+	return events
+}
+
+//tripUpdateEvent the trip level fields of a trip update
+func (f *Feed) tripUpdateEvent(tripupdate *transit_realtime.TripUpdate) beat.Event {
+	event := beat.Event{
+		Fields: common.MapStr{},
+	}
+	if tripupdate.Timestamp != nil {
+		event.Timestamp = time.Unix(int64(*tripupdate.Timestamp), 0)
+	} else {
+		event.Timestamp = f.now()
+	}
+	event.PutValue("type", "trip_update")
+	f.addScheduledTrip(tripupdate.Trip, &event)
+	f.addRoute(f.routeID(tripupdate.Trip), &event)
+	addVehicleDescriptors(tripupdate.Vehicle, &event)
+	addInt32IfNotNull("delay", tripupdate.Delay, &event)
+	return event
+}
+
+func (f *Feed) addStopByID(stopID string, e *beat.Event) {
+	e.PutValue("stop.id", stopID)
+	if stop, ok := f.Static().Stops[stopID]; ok {
+		addStop(stop, e)
+	} else {
+		f.recordUnknownStop()
+		logp.Debug("gtfsbeat", "Unrecognized stop id %s", stopID)
 	}
 }
 
@@ -374,97 +417,66 @@ func New(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
 		return nil, fmt.Errorf("Error reading config file: %v", err)
 	}
 	bt := &Gtfsbeat{
-		done:        make(chan struct{}),
-		config:      c,
-		lastUpdated: time.Now().UTC(),
-	}
-	var err error
-	bt.Stops, err = parseStops(c.Stops)
-	if err != nil {
-		logp.Error(err)
-		return nil, err
+		done:   make(chan struct{}),
+		config: c,
 	}
-	return bt, nil
-}
-
-//GetGtfsFeed gathers the feed entity
-func (bt *Gtfsbeat) GetGtfsFeed() ([]*transit_realtime.FeedEntity, error) {
-	client := &http.Client{}
-	req, err := http.NewRequest("GET", bt.config.URL, nil)
-	if err != nil {
-		return nil, err
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	logp.Debug("Received gtfs feed: %s", resp.Status)
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-	feed := transit_realtime.FeedMessage{}
-	if resp.StatusCode != 200 {
-		sbody := string(body)
-		logp.Warn("Received gtfs realtime response but with errors: %s", sbody)
-		return feed.Entity, errors.New(sbody)
-	}
-	if resp.Header.Get("Last-Modified") != "" {
-		if lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err != nil {
-			if lastModified.Before(bt.lastUpdated) {
-				logp.Info("Data has not been updated since %s. Last update %s", lastModified, bt.lastUpdated)
-				return nil, nil
+	// Feeds sharing a static bundle share its lookup tables
+	bundles := map[config.StaticConfig]*StaticBundle{}
+	for _, feedConfig := range c.GetFeeds() {
+		bundle, ok := bundles[*feedConfig.Static]
+		if !ok {
+			var err error
+			bundle, err = LoadStaticBundle(*feedConfig.Static)
+			if err != nil {
+				logp.Error(err)
+				return nil, err
 			}
-			bt.lastUpdated = lastModified
+			bundles[*feedConfig.Static] = bundle
+			bt.bundles = append(bt.bundles, bundle)
 		}
+		bt.Feeds = append(bt.Feeds, NewFeed(feedConfig, bundle))
 	}
-	if err := proto.Unmarshal(body, &feed); err != nil {
-		logp.Error(err)
-		return nil, err
-	}
-	return feed.GetEntity(), nil
+	return bt, nil
 }
 
 // Run starts gtfsbeat.
 func (bt *Gtfsbeat) Run(b *beat.Beat) error {
 	logp.Info("gtfsbeat is running! Hit CTRL-C to stop it.")
 
-	var err error
-	bt.client, err = b.Publisher.Connect()
-	if err != nil {
-		return err
+	var wg sync.WaitGroup
+	run := func(runner func(client beat.Client, done <-chan struct{})) error {
+		client, err := b.Publisher.Connect()
+		if err != nil {
+			// Stops the runners already started, libbeat calls Stop as well
+			bt.Stop()
+			wg.Wait()
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer client.Close()
+			runner(client, bt.done)
+		}()
+		return nil
 	}
-
-	ticker := time.NewTicker(bt.config.Period)
-	counter := 1
-	for {
-		select {
-		case <-bt.done:
-			return nil
-		case <-ticker.C:
+	for _, feed := range bt.Feeds {
+		if err := run(feed.Run); err != nil {
+			return err
 		}
-		feedentity, err := bt.GetGtfsFeed()
-		events := []beat.Event{}
-		if err != nil {
-			logp.Error(err)
-		} else if feedentity != nil {
-			for _, entity := range feedentity {
-				if entity.Vehicle != nil {
-					events = append(events, bt.TransformVehicle(entity.Vehicle))
-				}
+	}
+	for _, bundle := range bt.bundles {
+		if bundle.config.Refresh > 0 {
+			if err := run(bundle.Run); err != nil {
+				return err
 			}
 		}
-		if len(events) > 0 {
-			bt.client.PublishAll(events)
-		}
-		logp.Info("Events sent: %d", len(events))
-		counter++
 	}
+	wg.Wait()
+	return nil
 }
 
 // Stop stops gtfsbeat.
 func (bt *Gtfsbeat) Stop() {
-	bt.client.Close()
-	close(bt.done)
+	bt.stop.Do(func() { close(bt.done) })
 }
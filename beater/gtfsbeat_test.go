@@ -0,0 +1,190 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestDenormalizeTripUpdate(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{
+		Stops: map[string]Stop{
+			"100": {ID: "100", Name: "Main St"},
+		},
+	}))
+	tripUpdate := &transit_realtime.TripUpdate{
+		Trip: &transit_realtime.TripDescriptor{
+			TripId:  proto.String("trip1"),
+			RouteId: proto.String("route1"),
+		},
+		Vehicle: &transit_realtime.VehicleDescriptor{
+			Id: proto.String("bus1"),
+		},
+		Timestamp: proto.Uint64(1500000000),
+		StopTimeUpdate: []*transit_realtime.TripUpdate_StopTimeUpdate{
+			{
+				StopSequence: proto.Uint32(1),
+				StopId:       proto.String("100"),
+				Arrival: &transit_realtime.TripUpdate_StopTimeEvent{
+					Delay:       proto.Int32(60),
+					Time:        proto.Int64(1500000060),
+					Uncertainty: proto.Int32(30),
+				},
+			},
+			{
+				StopSequence: proto.Uint32(2),
+				StopId:       proto.String("200"),
+				Departure: &transit_realtime.TripUpdate_StopTimeEvent{
+					Delay: proto.Int32(-30),
+				},
+				ScheduleRelationship: transit_realtime.TripUpdate_StopTimeUpdate_SKIPPED.Enum(),
+			},
+		},
+	}
+
+	events := f.DenormalizeTripUpdate(tripUpdate)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	expected := []map[string]interface{}{
+		{
+			"type":                "trip_update",
+			"trip.id":             "trip1",
+			"trip.route_id":       "route1",
+			"vehicle.id":          "bus1",
+			"stop_seq":            uint32(1),
+			"stop.id":             "100",
+			"stop.name":           "Main St",
+			"arrival.delay":       int32(60),
+			"arrival.time":        time.Unix(1500000060, 0).UTC(),
+			"arrival.uncertainty": int32(30),
+			"stop_relationship":   "SCHEDULED",
+		},
+		{
+			"type":              "trip_update",
+			"stop_seq":          uint32(2),
+			"stop.id":           "200",
+			"departure.delay":   int32(-30),
+			"stop_relationship": "SKIPPED",
+		},
+	}
+	for i, fields := range expected {
+		if !events[i].Timestamp.Equal(time.Unix(1500000000, 0)) {
+			t.Errorf("event %d: unexpected timestamp %v", i, events[i].Timestamp)
+		}
+		for key, want := range fields {
+			got, err := events[i].GetValue(key)
+			if err != nil {
+				t.Errorf("event %d: missing %s", i, key)
+				continue
+			}
+			if got != want {
+				t.Errorf("event %d: %s = %v, want %v", i, key, got, want)
+			}
+		}
+	}
+	if _, err := events[1].GetValue("arrival"); err == nil {
+		t.Errorf("event 1: unexpected arrival")
+	}
+}
+
+func TestDenormalizeTripUpdateWithoutStopTimes(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	events := f.DenormalizeTripUpdate(&transit_realtime.TripUpdate{
+		Trip: &transit_realtime.TripDescriptor{
+			TripId:               proto.String("trip1"),
+			ScheduleRelationship: transit_realtime.TripDescriptor_CANCELED.Enum(),
+		},
+		Delay: proto.Int32(120),
+	})
+	if len(events) != 1 {
+		t.Fatalf("expected a trip level event, got %d events", len(events))
+	}
+	for key, want := range map[string]interface{}{
+		"type":          "trip_update",
+		"trip.id":       "trip1",
+		"trip.state":    "CANCELED",
+		"delay":         int32(120),
+		"delay_seconds": int64(120),
+	} {
+		if got, _ := events[0].GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+	if _, err := events[0].GetValue("stop_seq"); err == nil {
+		t.Error("unexpected stop_seq on a trip level event")
+	}
+}
+
+func TestDenormalizeAlert(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test", Language: "es"}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	alert := &transit_realtime.Alert{
+		ActivePeriod: []*transit_realtime.TimeRange{
+			{Start: proto.Uint64(1500000000)},
+		},
+		InformedEntity: []*transit_realtime.EntitySelector{
+			{AgencyId: proto.String("agency"), RouteId: proto.String("route1"), RouteType: proto.Int32(3)},
+			{StopId: proto.String("100")},
+		},
+		Cause:  transit_realtime.Alert_CONSTRUCTION.Enum(),
+		Effect: transit_realtime.Alert_DETOUR.Enum(),
+		HeaderText: &transit_realtime.TranslatedString{
+			Translation: []*transit_realtime.TranslatedString_Translation{
+				{Text: proto.String("Detour"), Language: proto.String("en")},
+				{Text: proto.String("Desvío"), Language: proto.String("es")},
+			},
+		},
+		DescriptionText: &transit_realtime.TranslatedString{},
+	}
+
+	events := f.DenormalizeAlert("alert1", alert)
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	// Alerts without a description are identified all the same, by entity
+	for i, want := range []string{"test-alert1-0", "test-alert1-1"} {
+		if id := events[i].Meta["_id"]; id != want {
+			t.Errorf("_id = %v, want %s", id, want)
+		}
+	}
+	for key, want := range map[string]interface{}{
+		"type":         "alert",
+		"alert_cause":  "CONSTRUCTION",
+		"alert_effect": "DETOUR",
+		"agency_id":    "agency",
+		"route_id":     "route1",
+		"route_type":   int32(3),
+		"header":       "Desvío",
+	} {
+		if got, _ := events[0].GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+	if _, err := events[0].GetValue("description"); err == nil {
+		t.Errorf("unexpected description for empty translation")
+	}
+	if got, _ := events[1].GetValue("stop.id"); got != "100" {
+		t.Errorf("stop.id = %v, want 100", got)
+	}
+	if _, err := events[1].GetValue("route_id"); err == nil {
+		t.Errorf("unexpected route_id on stop entity")
+	}
+}
+
+func TestStopTwice(t *testing.T) {
+	bt := &Gtfsbeat{done: make(chan struct{})}
+	// Run stops the beat itself when it fails to connect, before libbeat calls Stop
+	bt.Stop()
+	bt.Stop()
+	select {
+	case <-bt.done:
+	default:
+		t.Error("expected the beat to be stopped")
+	}
+}
@@ -0,0 +1,217 @@
+package beater
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//maxHeadwayHistory how long the progress of a vehicle is remembered, for the
+//vehicles following it to be timed against
+const maxHeadwayHistory = 2 * time.Hour
+
+//progressSample how far along its shape a vehicle was at a time
+type progressSample struct {
+	along     float64
+	timestamp time.Time
+}
+
+//withSample the progress history of a vehicle extended by a new sample, restarted
+//when the vehicle starts another trip
+func (p shapeProgress) withSample(previous shapeProgress, found bool) shapeProgress {
+	if found && previous.tripID == p.tripID {
+		for _, sample := range previous.history {
+			if p.timestamp.Sub(sample.timestamp) <= maxHeadwayHistory && sample.timestamp.Before(p.timestamp) {
+				p.history = append(p.history, sample)
+			}
+		}
+	}
+	p.history = append(p.history, progressSample{along: p.along, timestamp: p.timestamp})
+	return p
+}
+
+//passedAt when the vehicle passed the given distance along its shape,
+//interpolated between the samples either side of it
+func (p shapeProgress) passedAt(along float64) (time.Time, bool) {
+	for i, sample := range p.history {
+		if sample.along < along {
+			continue
+		}
+		if i == 0 {
+			// Passed before it was first seen
+			return time.Time{}, false
+		}
+		previous := p.history[i-1]
+		fraction := 0.0
+		if sample.along > previous.along {
+			fraction = (along - previous.along) / (sample.along - previous.along)
+		}
+		return previous.timestamp.Add(time.Duration(fraction * float64(sample.timestamp.Sub(previous.timestamp)))), true
+	}
+	return time.Time{}, false
+}
+
+//speed the average speed along the shape over the recent history of the vehicle
+func (p shapeProgress) speed() (float64, bool) {
+	var first *progressSample
+	for i := range p.history {
+		if p.timestamp.Sub(p.history[i].timestamp) <= maxDerivationGap {
+			first = &p.history[i]
+			break
+		}
+	}
+	if first == nil {
+		return 0, false
+	}
+	elapsed := p.timestamp.Sub(first.timestamp).Seconds()
+	if elapsed <= 0 || p.along <= first.along {
+		return 0, false
+	}
+	return (p.along - first.along) / elapsed, true
+}
+
+//routeVehicle a vehicle in the order of the vehicles of its route and direction
+type routeVehicle struct {
+	key      string
+	progress shapeProgress
+}
+
+//headwayGroup the route and direction of the vehicle, and the shape its
+//progress is measured along, as only progress along the same shape compares
+func (s *Static) headwayGroup(progress shapeProgress) (string, bool) {
+	scheduled, ok := s.Trips[progress.tripID]
+	if !ok {
+		return "", false
+	}
+	routeID := progress.trip.GetRouteId()
+	if routeID == "" {
+		routeID = scheduled.RouteID
+	}
+	direction := progress.trip.DirectionId
+	if direction == nil {
+		direction = scheduled.DirectionID
+	}
+	if routeID == "" {
+		return "", false
+	}
+	group := routeID + "|" + scheduled.ShapeID
+	if direction != nil {
+		group = fmt.Sprintf("%s|%s|%d", routeID, scheduled.ShapeID, *direction)
+	}
+	return group, true
+}
+
+//HeadwayEvents orders the vehicles of the current entities on every route and
+//direction by their progress along the route, returning a headway event for
+//every vehicle following another. Vehicles that left the feed are not
+//compared, even while their progress is remembered.
+func (f *Feed) HeadwayEvents(entities []*transit_realtime.FeedEntity) []beat.Event {
+	static := f.Static()
+	groups := map[string][]routeVehicle{}
+	for _, entity := range entities {
+		if entity.Vehicle == nil {
+			continue
+		}
+		key := vehicleKey(entity.Vehicle)
+		progress, ok := f.progress[key]
+		if key == "" || !ok {
+			continue
+		}
+		if group, ok := static.headwayGroup(progress); ok {
+			groups[group] = append(groups[group], routeVehicle{key: key, progress: progress})
+		}
+	}
+	names := make([]string, 0, len(groups))
+	for group := range groups {
+		names = append(names, group)
+	}
+	sort.Strings(names)
+	events := []beat.Event{}
+	for _, group := range names {
+		vehicles := groups[group]
+		sort.Slice(vehicles, func(i, j int) bool {
+			if vehicles[i].progress.along != vehicles[j].progress.along {
+				return vehicles[i].progress.along > vehicles[j].progress.along
+			}
+			return vehicles[i].key < vehicles[j].key
+		})
+		for i := 1; i < len(vehicles); i++ {
+			events = append(events, f.headwayEvent(vehicles[i-1].progress, vehicles[i].progress))
+		}
+	}
+	return events
+}
+
+//headway the time since the leader was where the follower is, or else the
+//time the follower needs to cover the distance between them at its speed
+func headway(leader, follower shapeProgress) (time.Duration, bool) {
+	if passed, ok := leader.passedAt(follower.along); ok {
+		return follower.timestamp.Sub(passed), true
+	}
+	if speed, ok := follower.speed(); ok {
+		return time.Duration((leader.along - follower.along) / speed * float64(time.Second)), true
+	}
+	return 0, false
+}
+
+//scheduledHeadway the headway of the frequency the leader runs at, or else
+//the time between the scheduled starts of the two trips
+func (s *Static) scheduledHeadway(leader, follower shapeProgress) (time.Duration, bool) {
+	serviceDate, leaderStart, ok := s.StartTime(leader.trip, leader.timestamp)
+	if !ok {
+		return 0, false
+	}
+	if frequencies := s.Frequencies[leader.tripID]; len(frequencies) > 0 {
+		start := ServiceTime(leaderStart.Sub(serviceDayStart(serviceDate, s.Location(leader.trip))) / time.Second)
+		for _, frequency := range frequencies {
+			if start >= frequency.StartTime && start < frequency.EndTime && frequency.HeadwaySecs > 0 {
+				return time.Duration(frequency.HeadwaySecs) * time.Second, true
+			}
+		}
+	}
+	_, followerStart, ok := s.StartTime(follower.trip, follower.timestamp)
+	if !ok || !followerStart.After(leaderStart) {
+		return 0, false
+	}
+	return followerStart.Sub(leaderStart), true
+}
+
+func (f *Feed) headwayEvent(leader, follower shapeProgress) beat.Event {
+	event := beat.Event{
+		Timestamp: follower.timestamp,
+		Fields:    common.MapStr{},
+	}
+	event.PutValue("type", "headway")
+	event.PutValue("feed.name", f.Name())
+	f.addScheduledTrip(follower.trip, &event)
+	f.addRoute(f.routeID(follower.trip), &event)
+	addVehicleDescriptors(follower.vehicle, &event)
+	event.PutValue("shape_dist_traveled", follower.along)
+	addStringIfNotEmpty("headway.leader.vehicle_id", leader.vehicle.GetId(), &event)
+	addStringIfNotEmpty("headway.leader.trip_id", leader.tripID, &event)
+	event.PutValue("headway.distance_meters", leader.along-follower.along)
+	actual, ok := headway(leader, follower)
+	if !ok {
+		return event
+	}
+	event.PutValue("headway.seconds", int64(actual/time.Second))
+	scheduled, ok := f.Static().scheduledHeadway(leader, follower)
+	if !ok {
+		return event
+	}
+	ratio := actual.Seconds() / scheduled.Seconds()
+	event.PutValue("headway.scheduled_seconds", int64(scheduled/time.Second))
+	event.PutValue("headway.ratio", ratio)
+	if f.config.Headway.BunchingRatio > 0 {
+		event.PutValue("bunching", ratio < f.config.Headway.BunchingRatio)
+	}
+	if f.config.Headway.GapRatio > 0 {
+		event.PutValue("gap", ratio > f.config.Headway.GapRatio)
+	}
+	return event
+}
@@ -0,0 +1,115 @@
+// +build !integration
+
+package beater
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func headwayPosition(id, tripID, startTime string, lat float32, at time.Time) *transit_realtime.VehiclePosition {
+	position := vehiclePosition(id, lat, -98.50, at)
+	position.Trip = &transit_realtime.TripDescriptor{TripId: proto.String(tripID), StartTime: proto.String(startTime), StartDate: proto.String("20190301")}
+	return position
+}
+
+func TestHeadwayEvents(t *testing.T) {
+	static := shapeTestStatic()
+	static.Trips = map[string]ScheduledTrip{
+		"a": {RouteID: "r", ShapeID: "shape"},
+		"b": {RouteID: "r", ShapeID: "shape"},
+	}
+	f := NewFeed(config.FeedConfig{Name: "test", Headway: config.HeadwayConfig{BunchingRatio: 0.5, GapRatio: 1.5}}, NewStaticBundle(config.StaticConfig{}, static))
+	start := time.Date(2019, 3, 1, 10, 0, 0, 0, time.Local)
+	observe := func(id, tripID, startTime string, lat float32, at time.Time) *transit_realtime.FeedEntity {
+		position := headwayPosition(id, tripID, startTime, lat, at)
+		f.TransformVehicle(position)
+		return &transit_realtime.FeedEntity{Id: proto.String(id), Vehicle: position}
+	}
+	// The leader passes 333 meters along the route two minutes after it started
+	observe("1", "a", "10:00:00", 29.401, start)
+	entities := []*transit_realtime.FeedEntity{
+		observe("1", "a", "10:00:00", 29.405, start.Add(4*time.Minute)),
+		observe("2", "b", "10:10:00", 29.403, start.Add(6*time.Minute)),
+	}
+
+	events := f.HeadwayEvents(entities)
+	if len(events) != 1 {
+		t.Fatalf("expected a headway event for the follower, got %d", len(events))
+	}
+	event := events[0]
+	if id, _ := event.GetValue("vehicle.id"); id != "2" {
+		t.Errorf("expected the follower, got %v", id)
+	}
+	if leader, _ := event.GetValue("headway.leader.vehicle_id"); leader != "1" {
+		t.Errorf("expected the leader, got %v", leader)
+	}
+	if seconds, _ := event.GetValue("headway.seconds"); seconds == nil || math.Abs(float64(seconds.(int64))-240) > 2 {
+		t.Errorf("unexpected headway %v", seconds)
+	}
+	if scheduled, _ := event.GetValue("headway.scheduled_seconds"); scheduled != int64(600) {
+		t.Errorf("unexpected scheduled headway %v", scheduled)
+	}
+	if bunching, _ := event.GetValue("bunching"); bunching != true {
+		t.Errorf("expected bunching, got %v", bunching)
+	}
+	if gap, _ := event.GetValue("gap"); gap != false {
+		t.Errorf("expected no gap, got %v", gap)
+	}
+
+	// Frequency based trips are compared to the headway they run at
+	static.Frequencies = map[string][]Frequency{"a": {{TripID: "a", StartTime: 9 * 3600, EndTime: 11 * 3600, HeadwaySecs: 300}}}
+	event = f.HeadwayEvents(entities)[0]
+	if scheduled, _ := event.GetValue("headway.scheduled_seconds"); scheduled != int64(300) {
+		t.Errorf("unexpected scheduled headway %v", scheduled)
+	}
+	if bunching, _ := event.GetValue("bunching"); bunching != false {
+		t.Errorf("expected no bunching, got %v", bunching)
+	}
+}
+
+func TestHeadwayEventsSkipVehiclesThatLeft(t *testing.T) {
+	static := shapeTestStatic()
+	static.Trips = map[string]ScheduledTrip{
+		"a": {RouteID: "r", ShapeID: "shape"},
+		"b": {RouteID: "r", ShapeID: "shape"},
+		"c": {RouteID: "r", ShapeID: "shape"},
+	}
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, static))
+	start := time.Date(2019, 3, 1, 10, 0, 0, 0, time.Local)
+	headways := func(at time.Time, positions ...*transit_realtime.VehiclePosition) []string {
+		f.clock = func() time.Time { return at }
+		entities := []*transit_realtime.FeedEntity{}
+		for _, position := range positions {
+			entities = append(entities, &transit_realtime.FeedEntity{Id: proto.String(position.Vehicle.GetId()), Vehicle: position})
+		}
+		pairs := []string{}
+		for _, event := range f.ProcessFeed(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, entities...)) {
+			if eventType, _ := event.GetValue("type"); eventType == "headway" {
+				follower, _ := event.GetValue("vehicle.id")
+				leader, _ := event.GetValue("headway.leader.vehicle_id")
+				pairs = append(pairs, leader.(string)+">"+follower.(string))
+			}
+		}
+		return pairs
+	}
+	pairs := headways(start,
+		headwayPosition("1", "a", "10:00:00", 29.401, start),
+		headwayPosition("3", "c", "09:50:00", 29.406, start))
+	if len(pairs) != 1 || pairs[0] != "3>1" {
+		t.Fatalf("expected 1 to follow 3, got %v", pairs)
+	}
+	// 3 finished its trip and left the feed, 1 now leads
+	pairs = headways(start.Add(6*time.Minute),
+		headwayPosition("1", "a", "10:00:00", 29.405, start.Add(6*time.Minute)),
+		headwayPosition("2", "b", "10:10:00", 29.403, start.Add(6*time.Minute)))
+	if len(pairs) != 1 || pairs[0] != "1>2" {
+		t.Errorf("expected only 2 to follow 1, got %v", pairs)
+	}
+}
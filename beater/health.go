@@ -0,0 +1,133 @@
+package beater
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//feedStats what was observed while polling a feed. The counters cover the
+//period since the last health event, the other values the last poll.
+type feedStats struct {
+	statusCode    int
+	latency       time.Duration
+	bytes         int
+	vehicles      int
+	tripUpdates   int
+	alerts        int
+	headerLag     *time.Duration
+	polls         int
+	failedPolls   int
+	parseErrors   int
+	unknownStops  int
+	unknownTrips  int
+	unknownRoutes int
+}
+
+//resetCounters starts a new health period
+func (s *feedStats) resetCounters() {
+	s.polls = 0
+	s.failedPolls = 0
+	s.parseErrors = 0
+	s.unknownStops = 0
+	s.unknownTrips = 0
+	s.unknownRoutes = 0
+}
+
+func (f *Feed) recordPoll(err error) {
+	f.stats.polls++
+	f.metrics.polls.Inc()
+	if err != nil {
+		f.stats.failedPolls++
+		f.metrics.failedPolls.Inc()
+	}
+}
+
+func (f *Feed) recordResponse(statusCode int, latency time.Duration, bytes int) {
+	f.stats.statusCode = statusCode
+	f.stats.latency = latency
+	f.stats.bytes = bytes
+	f.metrics.statusCode.Set(int64(statusCode))
+	f.metrics.latency.Set(int64(latency / time.Millisecond))
+	f.metrics.bytes.Set(int64(bytes))
+}
+
+func (f *Feed) recordParseError() {
+	f.stats.parseErrors++
+	f.metrics.parseErrors.Inc()
+}
+
+//recordMessage counts the entities of the feed state by type and how old the message was when processed
+func (f *Feed) recordMessage(header *transit_realtime.FeedHeader, now time.Time) {
+	f.stats.vehicles, f.stats.tripUpdates, f.stats.alerts = 0, 0, 0
+	for _, entity := range f.state.Entities() {
+		if entity.Vehicle != nil {
+			f.stats.vehicles++
+		}
+		if entity.TripUpdate != nil {
+			f.stats.tripUpdates++
+		}
+		if entity.Alert != nil {
+			f.stats.alerts++
+		}
+	}
+	f.metrics.vehicles.Set(int64(f.stats.vehicles))
+	f.metrics.tripUpdates.Set(int64(f.stats.tripUpdates))
+	f.metrics.alerts.Set(int64(f.stats.alerts))
+	if header.GetTimestamp() != 0 {
+		lag := now.Sub(time.Unix(int64(header.GetTimestamp()), 0))
+		f.stats.headerLag = &lag
+		f.metrics.headerLag.Set(int64(lag / time.Second))
+	}
+}
+
+func (f *Feed) recordUnknownStop() {
+	f.stats.unknownStops++
+	f.metrics.unknownStops.Inc()
+}
+
+func (f *Feed) recordUnknownTrip() {
+	f.stats.unknownTrips++
+	f.metrics.unknownTrips.Inc()
+}
+
+func (f *Feed) recordUnknownRoute() {
+	f.stats.unknownRoutes++
+	f.metrics.unknownRoutes.Inc()
+}
+
+//healthEvent reports the health of the feed together with what was observed while polling it
+func (f *Feed) healthEvent(now time.Time) beat.Event {
+	event := beat.Event{
+		Timestamp: now,
+		Fields:    common.MapStr{},
+	}
+	event.PutValue("type", "feed_health")
+	event.PutValue("feed.name", f.Name())
+	event.PutValue("health.state", f.breaker.state)
+	event.PutValue("health.consecutive_failures", f.breaker.failures)
+	if f.breaker.lastError != nil {
+		event.PutValue("health.last_error", f.breaker.lastError.Error())
+	}
+	event.PutValue("health.polls", f.stats.polls)
+	event.PutValue("health.failed_polls", f.stats.failedPolls)
+	event.PutValue("health.parse_errors", f.stats.parseErrors)
+	if f.stats.statusCode != 0 {
+		event.PutValue("http.status_code", f.stats.statusCode)
+		event.PutValue("http.latency_ms", int64(f.stats.latency/time.Millisecond))
+		event.PutValue("http.bytes", f.stats.bytes)
+	}
+	if f.stats.headerLag != nil {
+		event.PutValue("feed.age_seconds", int64(*f.stats.headerLag/time.Second))
+	}
+	event.PutValue("entities.vehicle", f.stats.vehicles)
+	event.PutValue("entities.trip_update", f.stats.tripUpdates)
+	event.PutValue("entities.alert", f.stats.alerts)
+	event.PutValue("unknown.stops", f.stats.unknownStops)
+	event.PutValue("unknown.trips", f.stats.unknownTrips)
+	event.PutValue("unknown.routes", f.stats.unknownRoutes)
+	return event
+}
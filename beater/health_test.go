@@ -0,0 +1,72 @@
+// +build !integration
+
+package beater
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestHealthEventReportsPolls(t *testing.T) {
+	message := feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"), vehicleEntity("2", "b"))
+	message.Header.Timestamp = proto.Uint64(uint64(time.Now().Add(-time.Minute).Unix()))
+	message.Entity[0].Vehicle.StopId = proto.String("unknown")
+	message.Entity[1].Vehicle.Trip = &transit_realtime.TripDescriptor{TripId: proto.String("unknown")}
+	data, err := proto.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrupt := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if corrupt {
+			w.Write([]byte("not a feed message"))
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	static := &Static{
+		Stops: map[string]Stop{"known": {}},
+		Trips: map[string]ScheduledTrip{"known": {}},
+	}
+	f := NewFeed(config.FeedConfig{Name: "health", URL: server.URL}, NewStaticBundle(config.StaticConfig{}, static))
+	f.poll(make(chan struct{}))
+	corrupt = true
+	f.poll(make(chan struct{}))
+
+	event := f.healthEvent(time.Now())
+	for key, want := range map[string]interface{}{
+		"type":                "feed_health",
+		"feed.name":           "health",
+		"health.polls":        2,
+		"health.failed_polls": 1,
+		"health.parse_errors": 1,
+		"http.status_code":    200,
+		"http.bytes":          len("not a feed message"),
+		"entities.vehicle":    2,
+		"entities.alert":      0,
+		"unknown.stops":       1,
+		"unknown.trips":       1,
+		"unknown.routes":      0,
+	} {
+		if got, _ := event.GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+	if age, _ := event.GetValue("feed.age_seconds"); age.(int64) < 60 {
+		t.Errorf("feed.age_seconds = %v", age)
+	}
+	f.stats.resetCounters()
+	event = f.healthEvent(time.Now())
+	if polls, _ := event.GetValue("health.polls"); polls != 0 {
+		t.Errorf("expected the counters to be reset, got %v polls", polls)
+	}
+}
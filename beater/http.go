@@ -0,0 +1,71 @@
+package beater
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+//newFeedClient an http client kept for the lifetime of the feed so that connections are reused between polls
+func newFeedClient(c config.FeedConfig) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   c.ConnectTimeout,
+		KeepAlive: 30 * time.Second,
+	}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   c.ConnectTimeout,
+		ResponseHeaderTimeout: c.Timeout,
+		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConnsPerHost:   2,
+		// Compression is negotiated by the feed itself to also accept deflate
+		DisableCompression: true,
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   c.Timeout,
+	}
+}
+
+//readBody reads the response body, decoding it according to its content encoding
+func readBody(resp *http.Response) ([]byte, error) {
+	var reader io.Reader = resp.Body
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip", "x-gzip":
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case "deflate":
+		return inflate(resp.Body)
+	}
+	return ioutil.ReadAll(reader)
+}
+
+//inflate decodes a deflate encoded body, which should be zlib wrapped but is
+//sent as a raw deflate stream by some servers
+func inflate(body io.Reader) ([]byte, error) {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if zlibReader, err := zlib.NewReader(bytes.NewReader(data)); err == nil {
+		defer zlibReader.Close()
+		return ioutil.ReadAll(zlibReader)
+	}
+	flateReader := flate.NewReader(bytes.NewReader(data))
+	defer flateReader.Close()
+	return ioutil.ReadAll(flateReader)
+}
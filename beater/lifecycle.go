@@ -0,0 +1,162 @@
+package beater
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//stuckRadius how far in meters a vehicle has to move to count as moving, beyond gps noise
+const stuckRadius = 25.0
+
+//vehicleActivity when a vehicle was first and last seen in the feed, and when
+//it last moved away from where it was
+type vehicleActivity struct {
+	firstSeen time.Time
+	lastSeen  time.Time
+	lastMoved time.Time
+	lat, lon  float64
+	located   bool
+	present   bool
+	trip      *transit_realtime.TripDescriptor
+	vehicle   *transit_realtime.VehicleDescriptor
+}
+
+//addActivity flags positions older than the stale threshold, vehicles on a
+//trip that have not moved for the stuck threshold and trips not scheduled on
+//the service date
+func (f *Feed) addActivity(vehicle *transit_realtime.VehiclePosition, e *beat.Event) {
+	now := f.now()
+	observedAt := f.observedAt(vehicle)
+	if vehicle.Timestamp != nil {
+		age := now.Sub(observedAt)
+		e.PutValue("position_age_seconds", int64(age/time.Second))
+		if f.config.Vehicle.StaleAfter > 0 {
+			e.PutValue("stale", age > f.config.Vehicle.StaleAfter)
+		}
+	}
+	if scheduled, ok := f.Static().ScheduledOn(vehicle.Trip, observedAt); ok {
+		e.PutValue("unscheduled_trip", !scheduled)
+		if !scheduled {
+			logp.Debug("gtfsbeat", "Trip %s of feed %s is not scheduled on its service date", vehicle.GetTrip().GetTripId(), f.Name())
+		}
+	}
+	key := vehicleKey(vehicle)
+	if key == "" {
+		return
+	}
+	activity, ok := f.activity[key]
+	if !ok {
+		activity = &vehicleActivity{firstSeen: observedAt, lastMoved: observedAt}
+		f.activity[key] = activity
+	}
+	if observedAt.After(activity.lastSeen) {
+		activity.lastSeen = observedAt
+	}
+	activity.trip, activity.vehicle = vehicle.Trip, vehicle.Vehicle
+	position := vehicle.GetPosition()
+	if position == nil || position.Latitude == nil || position.Longitude == nil {
+		return
+	}
+	lat, lon := float64(*position.Latitude), float64(*position.Longitude)
+	if !activity.located || haversine(activity.lat, activity.lon, lat, lon) > stuckRadius {
+		activity.lat, activity.lon, activity.located = lat, lon, true
+		if observedAt.After(activity.lastMoved) {
+			activity.lastMoved = observedAt
+		}
+	}
+	stationary := observedAt.Sub(activity.lastMoved)
+	e.PutValue("stationary_seconds", int64(stationary/time.Second))
+	if f.config.Vehicle.StuckAfter > 0 && vehicle.GetTrip().GetTripId() != "" {
+		// Vehicles waiting out of service, without a trip, are not stuck
+		e.PutValue("stuck", stationary > f.config.Vehicle.StuckAfter)
+	}
+}
+
+//ScheduledOn whether the trip runs on its start date, or else on the service
+//date of the reference time or the one before it for trips past midnight.
+//Unknown when the trip or the calendar of its service is not in the schedule.
+func (s *Static) ScheduledOn(trip *transit_realtime.TripDescriptor, reference time.Time) (bool, bool) {
+	if trip == nil || trip.TripId == nil {
+		return false, false
+	}
+	serviceID := s.Trips[*trip.TripId].ServiceID
+	if serviceID == "" {
+		return false, false
+	}
+	if _, ok := s.Calendars[serviceID]; !ok && len(s.CalendarDates[serviceID]) == 0 {
+		return false, false
+	}
+	loc := s.Location(trip)
+	if trip.StartDate != nil {
+		date, err := time.ParseInLocation("20060102", *trip.StartDate, loc)
+		if err != nil {
+			return false, false
+		}
+		return s.IsServiceActive(serviceID, date), true
+	}
+	reference = reference.In(loc)
+	return s.IsServiceActive(serviceID, reference) || s.IsServiceActive(serviceID, reference.AddDate(0, 0, -1)), true
+}
+
+//LifecycleEvents compares the vehicles of the feed with those of the previous
+//message, returning an event for every vehicle that appeared or disappeared.
+//The vehicles of the first message were there before the beat started and
+//have not appeared.
+func (f *Feed) LifecycleEvents(entities []*transit_realtime.FeedEntity, now time.Time) []beat.Event {
+	current := map[string]bool{}
+	events := []beat.Event{}
+	first := !f.vehiclesKnown
+	f.vehiclesKnown = true
+	for _, entity := range entities {
+		if entity.Vehicle == nil {
+			continue
+		}
+		key := vehicleKey(entity.Vehicle)
+		activity, ok := f.activity[key]
+		if key == "" || !ok {
+			continue
+		}
+		current[key] = true
+		if !activity.present {
+			activity.present = true
+			if !first {
+				events = append(events, f.lifecycleEvent("appeared", activity, now))
+			}
+		}
+	}
+	for key, activity := range f.activity {
+		if current[key] {
+			continue
+		}
+		if activity.present {
+			events = append(events, f.lifecycleEvent("disappeared", activity, now))
+		}
+		delete(f.activity, key)
+	}
+	return events
+}
+
+func (f *Feed) lifecycleEvent(state string, activity *vehicleActivity, now time.Time) beat.Event {
+	event := beat.Event{
+		Timestamp: now,
+		Fields:    common.MapStr{},
+	}
+	event.PutValue("type", "vehicle_lifecycle")
+	event.PutValue("feed.name", f.Name())
+	event.PutValue("lifecycle.state", state)
+	f.addScheduledTrip(activity.trip, &event)
+	f.addRoute(f.routeID(activity.trip), &event)
+	addVehicleDescriptors(activity.vehicle, &event)
+	event.PutValue("lifecycle.first_seen", activity.firstSeen.UTC())
+	event.PutValue("lifecycle.last_seen", activity.lastSeen.UTC())
+	if activity.located {
+		event.PutValue("pos", fmt.Sprintf("%f,%f", activity.lat, activity.lon))
+	}
+	return event
+}
@@ -0,0 +1,84 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestTransformVehicleFlagsActivity(t *testing.T) {
+	static := &Static{
+		Trips: map[string]ScheduledTrip{"t": {ServiceID: "weekday"}},
+		Calendars: map[string]Calendar{"weekday": {
+			ServiceID: "weekday",
+			Days:      [7]bool{false, true, true, true, true, true, false},
+			StartDate: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+	f := NewFeed(config.FeedConfig{Name: "test", Vehicle: config.VehicleConfig{StaleAfter: 5 * time.Minute, StuckAfter: 10 * time.Minute}}, NewStaticBundle(config.StaticConfig{}, static))
+	// A sunday, when the trip does not run
+	now := time.Date(2019, 3, 3, 10, 0, 0, 0, time.Local)
+	f.clock = func() time.Time { return now }
+
+	position := vehiclePosition("1", 29.4241, -98.4936, now.Add(-time.Hour))
+	position.Trip = &transit_realtime.TripDescriptor{TripId: proto.String("t")}
+	event := f.TransformVehicle(position)
+	for key, want := range map[string]interface{}{
+		"position_age_seconds": int64(3600),
+		"stale":                true,
+		"stationary_seconds":   int64(0),
+		"stuck":                false,
+		"unscheduled_trip":     true,
+	} {
+		if got, _ := event.GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	// Hardly moved since, on a trip of its start date
+	position = vehiclePosition("1", 29.4242, -98.4936, now)
+	position.Trip = &transit_realtime.TripDescriptor{TripId: proto.String("t"), StartDate: proto.String("20190301")}
+	event = f.TransformVehicle(position)
+	for key, want := range map[string]interface{}{
+		"stale":              false,
+		"stationary_seconds": int64(3600),
+		"stuck":              true,
+		"unscheduled_trip":   false,
+	} {
+		if got, _ := event.GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestLifecycleEvents(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	lifecycle := func(entities ...*transit_realtime.FeedEntity) map[string]string {
+		states := map[string]string{}
+		for _, event := range f.ProcessFeed(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, entities...)) {
+			if eventType, _ := event.GetValue("type"); eventType == "vehicle_lifecycle" {
+				id, _ := event.GetValue("vehicle.id")
+				state, _ := event.GetValue("lifecycle.state")
+				states[id.(string)] = state.(string)
+			}
+		}
+		return states
+	}
+	if states := lifecycle(vehicleEntity("1", "a")); len(states) != 0 {
+		t.Errorf("expected the vehicles at startup not to appear, got %v", states)
+	}
+	if states := lifecycle(vehicleEntity("1", "a"), vehicleEntity("2", "b")); len(states) != 1 || states["b"] != "appeared" {
+		t.Errorf("expected b to appear, got %v", states)
+	}
+	// The same vehicle under another entity id has not disappeared
+	if states := lifecycle(vehicleEntity("3", "a")); len(states) != 1 || states["b"] != "disappeared" {
+		t.Errorf("expected b to disappear, got %v", states)
+	}
+}
@@ -0,0 +1,64 @@
+package beater
+
+import (
+	"sync"
+
+	"github.com/elastic/beats/libbeat/monitoring"
+)
+
+var (
+	feedsRegistry = monitoring.Default.NewRegistry("gtfsbeat.feeds")
+	metricsMutex  sync.Mutex
+	metricsByFeed = map[string]*feedMetrics{}
+)
+
+//feedMetrics the monitoring counters of a single feed
+type feedMetrics struct {
+	headerTimestamp *monitoring.Int
+	headerLag       *monitoring.Int
+	unchanged       *monitoring.Int
+	stalled         *monitoring.Int
+	polls           *monitoring.Int
+	failedPolls     *monitoring.Int
+	parseErrors     *monitoring.Int
+	statusCode      *monitoring.Int
+	latency         *monitoring.Int
+	bytes           *monitoring.Int
+	vehicles        *monitoring.Int
+	tripUpdates     *monitoring.Int
+	alerts          *monitoring.Int
+	unknownStops    *monitoring.Int
+	unknownTrips    *monitoring.Int
+	unknownRoutes   *monitoring.Int
+}
+
+//getFeedMetrics the counters of the named feed, registered on first use since
+//a metric can only be registered once per process
+func getFeedMetrics(name string) *feedMetrics {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+	if metrics, ok := metricsByFeed[name]; ok {
+		return metrics
+	}
+	registry := feedsRegistry.NewRegistry(name)
+	metrics := &feedMetrics{
+		headerTimestamp: monitoring.NewInt(registry, "header_timestamp"),
+		headerLag:       monitoring.NewInt(registry, "header_lag_seconds"),
+		unchanged:       monitoring.NewInt(registry, "unchanged"),
+		stalled:         monitoring.NewInt(registry, "stalled"),
+		polls:           monitoring.NewInt(registry, "polls"),
+		failedPolls:     monitoring.NewInt(registry, "failed_polls"),
+		parseErrors:     monitoring.NewInt(registry, "parse_errors"),
+		statusCode:      monitoring.NewInt(registry, "http.status_code"),
+		latency:         monitoring.NewInt(registry, "http.latency_ms"),
+		bytes:           monitoring.NewInt(registry, "http.bytes"),
+		vehicles:        monitoring.NewInt(registry, "entities.vehicle"),
+		tripUpdates:     monitoring.NewInt(registry, "entities.trip_update"),
+		alerts:          monitoring.NewInt(registry, "entities.alert"),
+		unknownStops:    monitoring.NewInt(registry, "unknown.stops"),
+		unknownTrips:    monitoring.NewInt(registry, "unknown.trips"),
+		unknownRoutes:   monitoring.NewInt(registry, "unknown.routes"),
+	}
+	metricsByFeed[name] = metrics
+	return metrics
+}
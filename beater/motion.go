@@ -0,0 +1,132 @@
+package beater
+
+import (
+	"math"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+const (
+	earthRadiusMeters = 6371000.0
+	metersPerSecToMph = 2.2369362921
+	//maxPlausibleSpeed faster than any vehicle of a transit network (250km/h), jumps beyond are gps glitches
+	maxPlausibleSpeed = 70.0
+	//maxDerivationGap beyond which an average speed says little about the current one
+	maxDerivationGap = 10 * time.Minute
+	//minBearingDistance below which the heading is dominated by gps noise
+	minBearingDistance = 10.0
+)
+
+//vehicleTrack the last position of a vehicle, kept across polls
+type vehicleTrack struct {
+	lat, lon  float64
+	timestamp time.Time
+	speed     *float64
+	bearing   *float64
+}
+
+//vehicleKey identifies a vehicle across polls, empty when the feed does not identify it
+func vehicleKey(vehicle *transit_realtime.VehiclePosition) string {
+	if id := vehicle.GetVehicle().GetId(); id != "" {
+		return id
+	}
+	if label := vehicle.GetVehicle().GetLabel(); label != "" {
+		return "label:" + label
+	}
+	if tripID := vehicle.GetTrip().GetTripId(); tripID != "" {
+		return "trip:" + tripID
+	}
+	return ""
+}
+
+//haversine the great circle distance in meters between two coordinates
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	deltaPhi := (lat2 - lat1) * math.Pi / 180
+	deltaLambda := (lon2 - lon1) * math.Pi / 180
+	a := math.Sin(deltaPhi/2)*math.Sin(deltaPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(deltaLambda/2)*math.Sin(deltaLambda/2)
+	return 2 * earthRadiusMeters * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+//initialBearing the heading in degrees clockwise from north when travelling from the first coordinate to the second
+func initialBearing(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	deltaLambda := (lon2 - lon1) * math.Pi / 180
+	y := math.Sin(deltaLambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(deltaLambda)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+//addDerivedMotion adds the speed and bearing the feed omits, derived from the
+//previous position of the vehicle and flagged as derived
+func (f *Feed) addDerivedMotion(vehicle *transit_realtime.VehiclePosition, e *beat.Event) {
+	position := vehicle.GetPosition()
+	key := vehicleKey(vehicle)
+	if position == nil || position.Latitude == nil || position.Longitude == nil || key == "" || (position.Speed != nil && position.Bearing != nil) {
+		return
+	}
+	lat, lon := float64(position.GetLatitude()), float64(position.GetLongitude())
+	previous, ok := f.tracks[key]
+	if ok && e.Timestamp.Before(previous.timestamp) {
+		// Positions received out of order are not tracked
+		return
+	}
+	track := &vehicleTrack{lat: lat, lon: lon, timestamp: e.Timestamp}
+	if !ok {
+		f.tracks[key] = track
+		return
+	}
+	elapsed := e.Timestamp.Sub(previous.timestamp)
+	if elapsed == 0 && previous.lat == lat && previous.lon == lon {
+		// Republished unchanged, as the same position is in every full dataset
+		track.speed, track.bearing = previous.speed, previous.bearing
+	} else if elapsed > 0 && elapsed <= maxDerivationGap {
+		distance := haversine(previous.lat, previous.lon, lat, lon)
+		speed := distance / elapsed.Seconds()
+		if speed > maxPlausibleSpeed {
+			// The previous position stays the reference for the next one
+			logp.Debug("gtfsbeat", "Discarding implausible jump of vehicle %s at %.0f m/s", key, speed)
+			return
+		}
+		track.speed = &speed
+		if distance >= minBearingDistance {
+			bearing := initialBearing(previous.lat, previous.lon, lat, lon)
+			track.bearing = &bearing
+		} else if previous.bearing != nil {
+			// A stopped vehicle keeps facing the same way
+			track.bearing = previous.bearing
+		}
+	}
+	f.tracks[key] = track
+	derived := false
+	if position.Speed == nil && track.speed != nil {
+		e.PutValue("speed_meters_per_sec", *track.speed)
+		e.PutValue("speed_mph", *track.speed*metersPerSecToMph)
+		derived = true
+	}
+	if position.Bearing == nil && track.bearing != nil {
+		e.PutValue("bearing", *track.bearing)
+		derived = true
+	}
+	if derived {
+		e.PutValue("derived", true)
+	}
+}
+
+//pruneVehicles forgets vehicles that have not been seen for longer than a derivation gap
+func (f *Feed) pruneVehicles(now time.Time) {
+	for key, track := range f.tracks {
+		if now.Sub(track.timestamp) > maxDerivationGap {
+			delete(f.tracks, key)
+		}
+	}
+	for key, progress := range f.progress {
+		if now.Sub(progress.timestamp) > maxDerivationGap {
+			delete(f.progress, key)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// +build !integration
+
+package beater
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func vehiclePosition(id string, lat, lon float32, timestamp time.Time) *transit_realtime.VehiclePosition {
+	return &transit_realtime.VehiclePosition{
+		Vehicle:   &transit_realtime.VehicleDescriptor{Id: proto.String(id)},
+		Position:  &transit_realtime.Position{Latitude: proto.Float32(lat), Longitude: proto.Float32(lon)},
+		Timestamp: proto.Uint64(uint64(timestamp.Unix())),
+	}
+}
+
+func TestHaversineAndBearing(t *testing.T) {
+	// One degree of latitude northwards
+	if distance := haversine(29.0, -98.0, 30.0, -98.0); math.Abs(distance-111195) > 1 {
+		t.Errorf("unexpected distance %f", distance)
+	}
+	if bearing := initialBearing(29.0, -98.0, 30.0, -98.0); math.Abs(bearing) > 1e-9 {
+		t.Errorf("expected a northward bearing, got %f", bearing)
+	}
+	if bearing := initialBearing(29.0, -98.0, 29.0, -98.1); math.Abs(bearing-270) > 0.1 {
+		t.Errorf("expected a westward bearing, got %f", bearing)
+	}
+}
+
+func TestTransformVehicleDerivesMotion(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	start := time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+	event := f.TransformVehicle(vehiclePosition("1", 29.4241, -98.4936, start))
+	if derived, _ := event.GetValue("derived"); derived != nil {
+		t.Errorf("expected nothing derived from a single position, got %v", derived)
+	}
+	// About 111 meters north in 10 seconds
+	event = f.TransformVehicle(vehiclePosition("1", 29.4251, -98.4936, start.Add(10*time.Second)))
+	speed, _ := event.GetValue("speed_meters_per_sec")
+	if speed == nil || math.Abs(speed.(float64)-11.1) > 0.2 {
+		t.Errorf("unexpected speed %v", speed)
+	}
+	if bearing, _ := event.GetValue("bearing"); bearing == nil || math.Abs(bearing.(float64)) > 1 {
+		t.Errorf("unexpected bearing %v", bearing)
+	}
+	if derived, _ := event.GetValue("derived"); derived != true {
+		t.Errorf("expected derived values to be flagged, got %v", derived)
+	}
+	// Republished unchanged by the next full dataset
+	event = f.TransformVehicle(vehiclePosition("1", 29.4251, -98.4936, start.Add(10*time.Second)))
+	if again, _ := event.GetValue("speed_meters_per_sec"); again != speed {
+		t.Errorf("expected the derived speed to be kept, got %v", again)
+	}
+	// Several kilometers in 10 seconds is a glitch
+	event = f.TransformVehicle(vehiclePosition("1", 29.5251, -98.4936, start.Add(20*time.Second)))
+	if speed, _ := event.GetValue("speed_meters_per_sec"); speed != nil {
+		t.Errorf("expected an implausible jump to be discarded, got %v", speed)
+	}
+	// The next good position is measured from the last good one, about 111 meters in 20 seconds
+	event = f.TransformVehicle(vehiclePosition("1", 29.4261, -98.4936, start.Add(30*time.Second)))
+	if speed, _ := event.GetValue("speed_meters_per_sec"); speed == nil || math.Abs(speed.(float64)-5.56) > 0.1 {
+		t.Errorf("expected the speed since the last good position, got %v", speed)
+	}
+
+	position := vehiclePosition("2", 29.4241, -98.4936, start)
+	position.Position.Speed = proto.Float32(5)
+	position.Position.Bearing = proto.Float32(90)
+	f.TransformVehicle(position)
+	position = vehiclePosition("2", 29.4251, -98.4936, start.Add(10*time.Second))
+	position.Position.Speed = proto.Float32(5)
+	position.Position.Bearing = proto.Float32(90)
+	event = f.TransformVehicle(position)
+	if derived, _ := event.GetValue("derived"); derived != nil {
+		t.Errorf("expected reported motion to be kept, got derived %v", derived)
+	}
+}
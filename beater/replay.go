@@ -0,0 +1,188 @@
+package beater
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//replayWaitClose how long the published events of a replay may take to be acknowledged on exit
+const replayWaitClose = time.Minute
+
+//ReplayOptions what to replay and how fast
+type ReplayOptions struct {
+	// Path an archived snapshot or a directory searched for snapshots
+	Path string
+	// Feed the name of the configured feed the snapshots were read from
+	Feed string
+	// Speed how many times faster than originally the snapshots are replayed, 0 for as fast as possible
+	Speed float64
+}
+
+//Replayer publishes archived snapshots of a feed as if they were polled again
+type Replayer struct {
+	done    chan struct{}
+	options ReplayOptions
+	feed    *Feed
+	files   []string
+}
+
+//NewReplayer creates the beater replaying the archived snapshots of a configured feed
+func NewReplayer(options ReplayOptions) beat.Creator {
+	return func(b *beat.Beat, cfg *common.Config) (beat.Beater, error) {
+		files, err := replayFiles(options.Path)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("No snapshots found in %s", options.Path)
+		}
+		bt, err := New(b, cfg)
+		if err != nil {
+			return nil, err
+		}
+		r := &Replayer{
+			done:    make(chan struct{}),
+			options: options,
+			files:   files,
+		}
+		for _, feed := range bt.(*Gtfsbeat).Feeds {
+			if options.Feed == "" || feed.Name() == options.Feed {
+				r.feed = feed
+				break
+			}
+		}
+		if r.feed == nil {
+			return nil, fmt.Errorf("Unknown feed %s", options.Feed)
+		}
+		return r, nil
+	}
+}
+
+//replayFiles the snapshots at path in path order, which is chronological for archives
+func replayFiles(path string) ([]string, error) {
+	files := []string{}
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && (strings.HasSuffix(file, archiveExtension) || strings.HasSuffix(file, feedFileExtension)) {
+			files = append(files, file)
+		}
+		return nil
+	})
+	sort.Strings(files)
+	return files, err
+}
+
+//readSnapshot reads a feed message, gzipped when archived
+func readSnapshot(path string) (*transit_realtime.FeedMessage, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	var reader io.Reader = file
+	if strings.HasSuffix(path, ".gz") {
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	message := &transit_realtime.FeedMessage{}
+	if err := proto.Unmarshal(data, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// Run replays the snapshots.
+func (r *Replayer) Run(b *beat.Beat) error {
+	// The beat exits once the last snapshot is published, closing the client
+	// waits for the events still queued to be acknowledged
+	client, err := b.Publisher.ConnectWith(beat.ClientConfig{
+		PublishMode: beat.GuaranteedSend,
+		WaitClose:   replayWaitClose,
+	})
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	r.replay(client)
+	return nil
+}
+
+//replay publishes the events of every snapshot in turn until done is closed
+func (r *Replayer) replay(client beat.Client) {
+	logp.Info("Replaying %d snapshots of feed %s", len(r.files), r.feed.Name())
+	var previous time.Time
+	published := 0
+	for _, path := range r.files {
+		message, err := readSnapshot(path)
+		if err != nil {
+			logp.Err("Error reading snapshot %s: %v", path, err)
+			continue
+		}
+		timestamp := time.Unix(int64(message.GetHeader().GetTimestamp()), 0)
+		if message.GetHeader().GetTimestamp() == 0 {
+			// Snapshots without a header timestamp are timed by when they were written
+			info, err := os.Stat(path)
+			if err != nil {
+				logp.Err("Error reading snapshot %s: %v", path, err)
+				continue
+			}
+			timestamp = info.ModTime()
+		}
+		if !r.wait(previous, timestamp) {
+			break
+		}
+		previous = timestamp
+		// Events are timed as if the snapshot was processed when it was produced
+		r.feed.clock = func() time.Time { return timestamp }
+		events := r.feed.ProcessFeed(message)
+		if len(events) > 0 {
+			client.PublishAll(events)
+			published += len(events)
+		}
+		logp.Debug("gtfsbeat", "Replayed snapshot %s: %d events", path, len(events))
+	}
+	logp.Info("Replayed %d events of feed %s", published, r.feed.Name())
+}
+
+//wait sleeps for the time between two snapshots divided by the speed, returning false when stopped
+func (r *Replayer) wait(previous, next time.Time) bool {
+	delay := time.Duration(0)
+	if r.options.Speed > 0 && !previous.IsZero() && next.After(previous) {
+		delay = time.Duration(float64(next.Sub(previous)) / r.options.Speed)
+	}
+	select {
+	case <-r.done:
+		return false
+	case <-time.After(delay):
+		return true
+	}
+}
+
+// Stop stops the replay.
+func (r *Replayer) Stop() {
+	close(r.done)
+}
@@ -0,0 +1,116 @@
+// +build !integration
+
+package beater
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//collectingClient a publisher client keeping the published events
+type collectingClient struct {
+	events []beat.Event
+}
+
+func (c *collectingClient) Publish(event beat.Event) {
+	c.events = append(c.events, event)
+}
+
+func (c *collectingClient) PublishAll(events []beat.Event) {
+	c.events = append(c.events, events...)
+}
+
+func (c *collectingClient) Close() error {
+	return nil
+}
+
+//collectingPipeline a publisher pipeline connecting a collecting client
+type collectingPipeline struct {
+	client *collectingClient
+	config beat.ClientConfig
+}
+
+func (p *collectingPipeline) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func (p *collectingPipeline) ConnectWith(config beat.ClientConfig) (beat.Client, error) {
+	p.config = config
+	return p.client, nil
+}
+
+func TestReplayWaitsForPublishedEvents(t *testing.T) {
+	r := &Replayer{
+		done: make(chan struct{}),
+		feed: NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{})),
+	}
+	pipeline := &collectingPipeline{client: &collectingClient{}}
+	if err := r.Run(&beat.Beat{Publisher: pipeline}); err != nil {
+		t.Fatal(err)
+	}
+	if pipeline.config.PublishMode != beat.GuaranteedSend || pipeline.config.WaitClose <= 0 {
+		t.Errorf("expected the replay to wait for its events on close, got %+v", pipeline.config)
+	}
+}
+
+func TestReplayArchivedSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gtfsbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := newArchiver(config.ArchiveConfig{Path: dir}, "test")
+	first := time.Date(2019, 3, 1, 10, 0, 0, 0, time.UTC)
+	for i, entities := range [][]*transit_realtime.FeedEntity{
+		{vehicleEntity("1", "a"), vehicleEntity("2", "b")},
+		{vehicleEntity("2", "b")},
+	} {
+		message := feedMessage(transit_realtime.FeedHeader_FULL_DATASET, entities...)
+		timestamp := first.Add(time.Duration(i) * 30 * time.Second)
+		message.Header.Timestamp = proto.Uint64(uint64(timestamp.Unix()))
+		data, err := proto.Marshal(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := archive.Write(data, timestamp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := replayFiles(dir)
+	if err != nil || len(files) != 2 {
+		t.Fatalf("expected 2 snapshots, got %v, %v", files, err)
+	}
+
+	r := &Replayer{
+		done:    make(chan struct{}),
+		options: ReplayOptions{Path: dir, Speed: 1000},
+		feed:    NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{})),
+		files:   files,
+	}
+	client := &collectingClient{}
+	r.replay(client)
+	if len(client.events) != 5 {
+		t.Fatalf("expected 5 events, got %d", len(client.events))
+	}
+	deletion := client.events[3]
+	if eventType, _ := deletion.GetValue("type"); eventType != "deletion" {
+		t.Errorf("expected a deletion, got %v", eventType)
+	}
+	if !deletion.Timestamp.Equal(first.Add(30 * time.Second)) {
+		t.Errorf("expected the original timestamp, got %s", deletion.Timestamp)
+	}
+	if age, _ := deletion.GetValue("feed.age_seconds"); age != int64(0) {
+		t.Errorf("feed.age_seconds = %v", age)
+	}
+	if state, _ := client.events[4].GetValue("lifecycle.state"); state != "disappeared" {
+		t.Errorf("expected the deleted vehicle to disappear, got %v", state)
+	}
+}
@@ -0,0 +1,153 @@
+package beater
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+//maxErrorBody how much of an error response is kept in the error
+const maxErrorBody = 256
+
+const (
+	healthStateHealthy     = "healthy"
+	healthStateFailing     = "failing"
+	healthStateCircuitOpen = "circuit_open"
+	healthStateHalfOpen    = "half_open"
+)
+
+//httpError an unexpected response of a feed
+type httpError struct {
+	StatusCode int
+	Status     string
+	Body       string
+	RetryAfter time.Duration
+}
+
+func (e *httpError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("unexpected response %s", e.Status)
+	}
+	return fmt.Sprintf("unexpected response %s: %s", e.Status, e.Body)
+}
+
+//newHTTPError reads the start of an unexpected response, flattened to a single line
+func newHTTPError(resp *http.Response, now time.Time) *httpError {
+	err := &httpError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), now),
+	}
+	if resp.Header.Get("Content-Encoding") == "" {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		err.Body = strings.Join(strings.Fields(string(body)), " ")
+	}
+	return err
+}
+
+//parseRetryAfter the delay of a Retry-After header given in seconds or as an http date, 0 when absent
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+//retryable whether the request may succeed when sent again
+func retryable(err error) bool {
+	switch e := err.(type) {
+	case *httpError:
+		switch e.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+			http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	case *url.Error:
+		return true
+	}
+	return false
+}
+
+//backoff the jittered delay before the given retry, doubling with every attempt up to the maximum
+func backoff(c config.RetryConfig, attempt int) time.Duration {
+	delay := c.Backoff
+	for i := 0; i < attempt && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if c.MaxBackoff > 0 && delay > c.MaxBackoff {
+		delay = c.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	// Jitter keeps feeds of the same producer from retrying in lockstep
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+//circuitBreaker stops polling a feed after repeated failures, allowing a single
+//trial poll once the timeout elapsed
+type circuitBreaker struct {
+	threshold int
+	timeout   time.Duration
+	failures  int
+	openedAt  time.Time
+	state     string
+	lastError error
+}
+
+func newCircuitBreaker(c config.RetryConfig) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: c.CircuitThreshold,
+		timeout:   c.CircuitTimeout,
+		state:     healthStateHealthy,
+	}
+}
+
+//Allow whether the feed may be polled, moving an open circuit to half open once its timeout elapsed
+func (b *circuitBreaker) Allow(now time.Time) (bool, bool) {
+	if b.state != healthStateCircuitOpen {
+		return true, false
+	}
+	if now.Sub(b.openedAt) < b.timeout {
+		return false, false
+	}
+	b.state = healthStateHalfOpen
+	return true, true
+}
+
+//Success records a successful poll, returning whether the state changed
+func (b *circuitBreaker) Success() bool {
+	b.failures = 0
+	b.lastError = nil
+	changed := b.state != healthStateHealthy
+	b.state = healthStateHealthy
+	return changed
+}
+
+//Failure records a failed poll, returning whether the state changed
+func (b *circuitBreaker) Failure(err error, now time.Time) bool {
+	b.failures++
+	b.lastError = err
+	previous := b.state
+	if b.state == healthStateHalfOpen || (b.threshold > 0 && b.failures >= b.threshold) {
+		b.state = healthStateCircuitOpen
+		b.openedAt = now
+	} else {
+		b.state = healthStateFailing
+	}
+	return b.state != previous
+}
@@ -0,0 +1,111 @@
+// +build !integration
+
+package beater
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		value string
+		delay time.Duration
+	}{
+		{"", 0},
+		{"120", 2 * time.Minute},
+		{"Fri, 01 Mar 2019 12:00:30 GMT", 30 * time.Second},
+		{"Fri, 01 Mar 2019 11:00:00 GMT", 0},
+		{"soon", 0},
+	} {
+		if delay := parseRetryAfter(test.value, now); delay != test.delay {
+			t.Errorf("%q: expected %s, got %s", test.value, test.delay, delay)
+		}
+	}
+}
+
+func TestBackoffIsJitteredAndCapped(t *testing.T) {
+	c := config.RetryConfig{Backoff: time.Second, MaxBackoff: 4 * time.Second}
+	for attempt, max := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		delay := backoff(c, attempt)
+		if delay < max/2 || delay > max {
+			t.Errorf("attempt %d: delay %s outside [%s, %s]", attempt, delay, max/2, max)
+		}
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	b := newCircuitBreaker(config.RetryConfig{CircuitThreshold: 2, CircuitTimeout: time.Minute})
+	now := time.Now()
+	failure := errors.New("unavailable")
+	if !b.Failure(failure, now) || b.state != healthStateFailing {
+		t.Errorf("expected the feed to be failing, got %s", b.state)
+	}
+	if !b.Failure(failure, now) || b.state != healthStateCircuitOpen {
+		t.Errorf("expected the circuit to open, got %s", b.state)
+	}
+	if allowed, _ := b.Allow(now.Add(30 * time.Second)); allowed {
+		t.Error("expected an open circuit to block polls")
+	}
+	if allowed, changed := b.Allow(now.Add(time.Minute)); !allowed || !changed || b.state != healthStateHalfOpen {
+		t.Errorf("expected a trial poll, got %s", b.state)
+	}
+	if !b.Failure(failure, now.Add(time.Minute)) || b.state != healthStateCircuitOpen {
+		t.Errorf("expected a failed trial to open the circuit again, got %s", b.state)
+	}
+	b.Allow(now.Add(2 * time.Minute))
+	if !b.Success() || b.state != healthStateHealthy || b.failures != 0 {
+		t.Errorf("expected the feed to be healthy, got %s", b.state)
+	}
+}
+
+func TestPollRetriesUnavailableFeed(t *testing.T) {
+	data, err := proto.Marshal(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html>\n  <body>down for maintenance</body>\n</html>"))
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	retry := config.RetryConfig{MaxRetries: 1, Backoff: time.Millisecond, MaxBackoff: time.Millisecond, CircuitThreshold: 1, CircuitTimeout: time.Hour}
+	f := NewFeed(config.FeedConfig{Name: "test", URL: server.URL, Retry: retry}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	events := f.poll(make(chan struct{}))
+	if requests != 2 || len(events) != 1 {
+		t.Fatalf("expected 2 requests and 1 event, got %d and %d", requests, len(events))
+	}
+
+	retry.MaxRetries = 0
+	requests = 0
+	f = NewFeed(config.FeedConfig{Name: "test", URL: server.URL, Retry: retry}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	events = f.poll(make(chan struct{}))
+	if len(events) != 1 {
+		t.Fatalf("expected a health event, got %d events", len(events))
+	}
+	if state, _ := events[0].GetValue("health.state"); state != healthStateCircuitOpen {
+		t.Errorf("unexpected health state %v", state)
+	}
+	if lastError, _ := events[0].GetValue("health.last_error"); lastError != "unexpected response 503 Service Unavailable: <html> <body>down for maintenance</body> </html>" {
+		t.Errorf("unexpected error %v", lastError)
+	}
+	if events := f.poll(make(chan struct{})); len(events) != 0 || requests != 1 {
+		t.Errorf("expected the open circuit to skip the poll, got %d requests", requests)
+	}
+}
@@ -0,0 +1,93 @@
+package beater
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+var routeTypeLabels = map[int]string{
+	0:  "Tram",
+	1:  "Subway",
+	2:  "Rail",
+	3:  "Bus",
+	4:  "Ferry",
+	5:  "Cable tram",
+	6:  "Aerial lift",
+	7:  "Funicular",
+	11: "Trolleybus",
+	12: "Monorail",
+}
+
+//extendedRouteTypeLabels labels of the extended route types, grouped by hundreds
+var extendedRouteTypeLabels = map[int]string{
+	100:  "Railway Service",
+	200:  "Coach Service",
+	400:  "Urban Railway Service",
+	700:  "Bus Service",
+	800:  "Trolleybus Service",
+	900:  "Tram Service",
+	1000: "Water Transport Service",
+	1100: "Air Service",
+	1200: "Ferry Service",
+	1300: "Aerial Lift Service",
+	1400: "Funicular Service",
+	1500: "Taxi Service",
+	1700: "Miscellaneous Service",
+}
+
+//RouteTypeLabel a human readable label of a basic or extended gtfs route type
+func RouteTypeLabel(routeType int) string {
+	if label, ok := routeTypeLabels[routeType]; ok {
+		return label
+	}
+	return extendedRouteTypeLabels[routeType-routeType%100]
+}
+
+//Agency the agency operating the route, the only agency when the route does not name one
+func (s *Static) Agency(route Route) (Agency, bool) {
+	if agency, ok := s.Agencies[route.AgencyID]; ok {
+		return agency, true
+	}
+	if route.AgencyID == "" && len(s.Agencies) == 1 {
+		for _, agency := range s.Agencies {
+			return agency, true
+		}
+	}
+	return Agency{}, false
+}
+
+//routeID the route of the trip, from the realtime descriptor or else the static trip
+func (f *Feed) routeID(trip *transit_realtime.TripDescriptor) string {
+	if trip == nil {
+		return ""
+	}
+	if trip.RouteId != nil {
+		return *trip.RouteId
+	}
+	return f.Static().Trips[trip.GetTripId()].RouteID
+}
+
+func (f *Feed) addRoute(routeID string, e *beat.Event) {
+	if routeID == "" {
+		return
+	}
+	static := f.Static()
+	route, ok := static.Routes[routeID]
+	if !ok {
+		// routes.txt is optional, only routes missing from a loaded table are unknown
+		if len(static.Routes) > 0 {
+			f.recordUnknownRoute()
+		}
+		return
+	}
+	addStringIfNotEmpty("route.short_name", route.ShortName, e)
+	addStringIfNotEmpty("route.long_name", route.LongName, e)
+	e.PutValue("route.type", route.Type)
+	addStringIfNotEmpty("route.type_label", RouteTypeLabel(route.Type), e)
+	addStringIfNotEmpty("route.color", route.Color, e)
+	addStringIfNotEmpty("route.text_color", route.TextColor, e)
+	if agency, ok := static.Agency(route); ok {
+		addStringIfNotEmpty("agency.name", agency.Name, e)
+	}
+}
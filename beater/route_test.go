@@ -0,0 +1,60 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestRouteTypeLabel(t *testing.T) {
+	for routeType, label := range map[int]string{
+		3:    "Bus",
+		11:   "Trolleybus",
+		702:  "Bus Service",
+		1000: "Water Transport Service",
+		42:   "",
+	} {
+		if got := RouteTypeLabel(routeType); got != label {
+			t.Errorf("RouteTypeLabel(%d) = %q, want %q", routeType, got, label)
+		}
+	}
+}
+
+func TestVehicleRouteEnrichment(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{
+		Agencies: map[string]Agency{"VIA": {ID: "VIA", Name: "VIA Metropolitan Transit"}},
+		Routes: map[string]Route{
+			"2": {ID: "2", ShortName: "2", LongName: "Blanco", Type: 3, Color: "E31837", TextColor: "FFFFFF"},
+		},
+		Trips: map[string]ScheduledTrip{"t1": {ID: "t1", RouteID: "2"}},
+	}))
+
+	event := f.TransformVehicle(&transit_realtime.VehiclePosition{
+		Trip: &transit_realtime.TripDescriptor{TripId: proto.String("t1")},
+	})
+	for key, want := range map[string]interface{}{
+		"route.short_name": "2",
+		"route.long_name":  "Blanco",
+		"route.type":       3,
+		"route.type_label": "Bus",
+		"route.color":      "E31837",
+		"route.text_color": "FFFFFF",
+		"agency.name":      "VIA Metropolitan Transit",
+	} {
+		if got, _ := event.GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	event = f.TransformVehicle(&transit_realtime.VehiclePosition{
+		Trip: &transit_realtime.TripDescriptor{TripId: proto.String("unknown"), RouteId: proto.String("missing")},
+	})
+	if _, err := event.GetValue("route.long_name"); err == nil {
+		t.Error("unexpected route enrichment for unknown route")
+	}
+}
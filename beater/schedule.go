@@ -0,0 +1,184 @@
+package beater
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//serviceDayStart the "noon minus 12h" reference of a service date, which is
+//midnight except on days with a daylight saving time change
+func serviceDayStart(date time.Time, loc *time.Location) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 12, 0, 0, 0, loc).Add(-12 * time.Hour)
+}
+
+//On the time of day on the given service date
+func (t ServiceTime) On(date time.Time, loc *time.Location) time.Time {
+	return serviceDayStart(date, loc).Add(time.Duration(t) * time.Second)
+}
+
+func (s *Static) resolveLocations() {
+	s.locations = map[string]*time.Location{}
+	for id, agency := range s.Agencies {
+		if agency.Timezone == "" {
+			continue
+		}
+		loc, err := time.LoadLocation(agency.Timezone)
+		if err != nil {
+			logp.Warn("Unknown timezone %s of agency %s", agency.Timezone, agency.Name)
+			continue
+		}
+		s.locations[id] = loc
+	}
+}
+
+//Location the timezone the trip is scheduled in, that of its agency or else
+//that of its first stop
+func (s *Static) Location(trip *transit_realtime.TripDescriptor) *time.Location {
+	routeID := trip.GetRouteId()
+	if routeID == "" {
+		routeID = s.Trips[trip.GetTripId()].RouteID
+	}
+	agencyID := s.Routes[routeID].AgencyID
+	if loc, ok := s.locations[agencyID]; ok {
+		return loc
+	}
+	// agency_id may be omitted when the bundle holds a single agency
+	if len(s.locations) == 1 {
+		for _, loc := range s.locations {
+			return loc
+		}
+	}
+	if stopTimes := s.StopTimes[trip.GetTripId()]; len(stopTimes) > 0 {
+		if timezone := s.Stops[stopTimes[0].StopID].Timezone; timezone != "" {
+			if loc, err := time.LoadLocation(timezone); err == nil {
+				return loc
+			}
+		}
+	}
+	return time.Local
+}
+
+//ScheduledStopTime finds a scheduled stop of the trip by its sequence, or by its stop id when no sequence is known
+func (s *Static) ScheduledStopTime(tripID string, stopSequence *uint32, stopID *string) *StopTime {
+	stopTimes := s.StopTimes[tripID]
+	for i := range stopTimes {
+		if stopSequence != nil {
+			if stopTimes[i].StopSequence == *stopSequence {
+				return &stopTimes[i]
+			}
+		} else if stopID != nil && stopTimes[i].StopID == *stopID {
+			return &stopTimes[i]
+		}
+	}
+	return nil
+}
+
+//ServiceDate the service date of the trip, from its start date or else the
+//active service date on which the scheduled time is closest to reference
+func (s *Static) ServiceDate(trip *transit_realtime.TripDescriptor, scheduled ServiceTime, reference time.Time) (time.Time, bool) {
+	loc := s.Location(trip)
+	if trip.StartDate != nil {
+		date, err := time.ParseInLocation("20060102", *trip.StartDate, loc)
+		if err != nil {
+			logp.Warn("Invalid start date %s of trip %s", *trip.StartDate, trip.GetTripId())
+			return time.Time{}, false
+		}
+		return date, true
+	}
+	serviceID := s.Trips[trip.GetTripId()].ServiceID
+	reference = reference.In(loc)
+	var best time.Time
+	var bestDistance time.Duration
+	found := false
+	// Trips past midnight belong to the previous service date
+	for _, offset := range []int{0, -1, 1} {
+		date := reference.AddDate(0, 0, offset)
+		if serviceID != "" && !s.IsServiceActive(serviceID, date) {
+			continue
+		}
+		distance := scheduled.On(date, loc).Sub(reference)
+		if distance < 0 {
+			distance = -distance
+		}
+		if !found || distance < bestDistance {
+			best, bestDistance, found = date, distance, true
+		}
+	}
+	if !found {
+		return time.Time{}, false
+	}
+	year, month, day := best.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, loc), true
+}
+
+//addSchedule adds the scheduled arrival and departure at the stop, and the
+//deviation of the observed arrival, or else departure, from the schedule
+func (f *Feed) addSchedule(trip *transit_realtime.TripDescriptor, stopSequence *uint32, stopID *string, arrival, departure, reference time.Time, e *beat.Event) {
+	if trip == nil || trip.TripId == nil {
+		return
+	}
+	static := f.Static()
+	stopTime := static.ScheduledStopTime(*trip.TripId, stopSequence, stopID)
+	if stopTime == nil {
+		return
+	}
+	scheduled := stopTime.ArrivalTime
+	if !scheduled.Valid() {
+		scheduled = stopTime.DepartureTime
+	}
+	if !scheduled.Valid() {
+		// Untimed stops are interpolated by consumers, there is nothing to compare to
+		return
+	}
+	serviceDate, ok := static.ServiceDate(trip, scheduled, reference)
+	if !ok {
+		return
+	}
+	loc := static.Location(trip)
+	if stopTime.ArrivalTime.Valid() {
+		scheduledArrival := stopTime.ArrivalTime.On(serviceDate, loc)
+		e.PutValue("schedule.arrival", scheduledArrival.UTC())
+		if !arrival.IsZero() {
+			e.PutValue("delay_seconds", int64(arrival.Sub(scheduledArrival)/time.Second))
+			departure = time.Time{}
+		}
+	}
+	if stopTime.DepartureTime.Valid() {
+		scheduledDeparture := stopTime.DepartureTime.On(serviceDate, loc)
+		e.PutValue("schedule.departure", scheduledDeparture.UTC())
+		if !departure.IsZero() {
+			e.PutValue("delay_seconds", int64(departure.Sub(scheduledDeparture)/time.Second))
+		}
+	}
+}
+
+//StartTime when the trip starts, from its start time or else the first
+//scheduled departure, on its resolved service date
+func (s *Static) StartTime(trip *transit_realtime.TripDescriptor, reference time.Time) (time.Time, time.Time, bool) {
+	start := noServiceTime
+	if trip.StartTime != nil {
+		var err error
+		if start, err = parseServiceTime(*trip.StartTime); err != nil {
+			logp.Warn("Invalid start time %s of trip %s", *trip.StartTime, trip.GetTripId())
+			return time.Time{}, time.Time{}, false
+		}
+	} else if stopTimes := s.StopTimes[trip.GetTripId()]; len(stopTimes) > 0 {
+		start = stopTimes[0].DepartureTime
+		if !start.Valid() {
+			start = stopTimes[0].ArrivalTime
+		}
+	}
+	if !start.Valid() {
+		return time.Time{}, time.Time{}, false
+	}
+	serviceDate, ok := s.ServiceDate(trip, start, reference)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+	return serviceDate, start.On(serviceDate, s.Location(trip)), true
+}
@@ -0,0 +1,141 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func scheduleTestStatic() *Static {
+	s := &Static{
+		Agencies: map[string]Agency{"VIA": {ID: "VIA", Timezone: "America/Chicago"}},
+		Routes:   map[string]Route{"2": {ID: "2", AgencyID: "VIA"}},
+		Trips:    map[string]ScheduledTrip{"t1": {ID: "t1", RouteID: "2", ServiceID: "WK"}},
+		StopTimes: map[string][]StopTime{
+			"t1": {
+				{TripID: "t1", StopID: "100", StopSequence: 1, ArrivalTime: 23*3600 + 50*60, DepartureTime: 23*3600 + 50*60},
+				{TripID: "t1", StopID: "200", StopSequence: 2, ArrivalTime: 24*3600 + 10*60, DepartureTime: 24*3600 + 11*60},
+			},
+		},
+		Calendars: map[string]Calendar{
+			"WK": {
+				ServiceID: "WK",
+				Days:      [7]bool{false, true, true, true, true, true, false},
+				StartDate: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+	s.resolveLocations()
+	return s
+}
+
+func TestScheduleDeviation(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, scheduleTestStatic()))
+	chicago, _ := time.LoadLocation("America/Chicago")
+	// Friday 2019-07-05 00:13 local, the trip belongs to the Thursday service date
+	predicted := time.Date(2019, 7, 5, 0, 13, 0, 0, chicago)
+	tripUpdate := &transit_realtime.TripUpdate{
+		Trip: &transit_realtime.TripDescriptor{TripId: proto.String("t1")},
+		StopTimeUpdate: []*transit_realtime.TripUpdate_StopTimeUpdate{
+			{
+				StopSequence: proto.Uint32(2),
+				Arrival:      &transit_realtime.TripUpdate_StopTimeEvent{Time: proto.Int64(predicted.Unix())},
+			},
+		},
+	}
+
+	events := f.DenormalizeTripUpdate(tripUpdate)
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	scheduledArrival := time.Date(2019, 7, 5, 0, 10, 0, 0, chicago).UTC()
+	if got, _ := events[0].GetValue("schedule.arrival"); got != scheduledArrival {
+		t.Errorf("schedule.arrival = %v, want %v", got, scheduledArrival)
+	}
+	if got, _ := events[0].GetValue("schedule.departure"); got != scheduledArrival.Add(time.Minute) {
+		t.Errorf("schedule.departure = %v", got)
+	}
+	if got, _ := events[0].GetValue("delay_seconds"); got != int64(180) {
+		t.Errorf("delay_seconds = %v, want 180", got)
+	}
+
+	vehicle := &transit_realtime.VehiclePosition{
+		Trip:                &transit_realtime.TripDescriptor{TripId: proto.String("t1"), StartDate: proto.String("20190704")},
+		CurrentStopSequence: proto.Uint32(1),
+		Timestamp:           proto.Uint64(uint64(time.Date(2019, 7, 4, 23, 48, 0, 0, chicago).Unix())),
+	}
+	event := f.TransformVehicle(vehicle)
+	if got, _ := event.GetValue("schedule.arrival"); got != time.Date(2019, 7, 4, 23, 50, 0, 0, chicago).UTC() {
+		t.Errorf("schedule.arrival = %v", got)
+	}
+	// On its way to the stop, the vehicle has not arrived yet
+	if got, err := event.GetValue("delay_seconds"); err == nil {
+		t.Errorf("unexpected delay_seconds %v of a vehicle in transit", got)
+	}
+	vehicle.CurrentStatus = transit_realtime.VehiclePosition_STOPPED_AT.Enum()
+	event = f.TransformVehicle(vehicle)
+	if got, _ := event.GetValue("delay_seconds"); got != int64(-120) {
+		t.Errorf("delay_seconds = %v, want -120", got)
+	}
+}
+
+func TestServiceTimeOnDaylightSavingDay(t *testing.T) {
+	chicago, _ := time.LoadLocation("America/Chicago")
+	// Clocks went forward at 02:00 on 2019-03-10, service day times count from 23:00 the day before
+	date := time.Date(2019, 3, 10, 0, 0, 0, 0, chicago)
+	if got := ServiceTime(8*3600).On(date, chicago); !got.Equal(time.Date(2019, 3, 10, 8, 0, 0, 0, chicago)) {
+		t.Errorf("unexpected time %v", got)
+	}
+}
+
+func TestTripStartTime(t *testing.T) {
+	static := scheduleTestStatic()
+	chicago, _ := time.LoadLocation("America/Chicago")
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, static))
+
+	for _, test := range []struct {
+		trip      *transit_realtime.TripDescriptor
+		reference time.Time
+		date      time.Time
+		start     time.Time
+	}{
+		{
+			trip:      &transit_realtime.TripDescriptor{TripId: proto.String("t1"), StartDate: proto.String("20190704"), StartTime: proto.String("25:30:00")},
+			reference: time.Date(2019, 7, 5, 1, 0, 0, 0, chicago),
+			date:      time.Date(2019, 7, 4, 0, 0, 0, 0, chicago),
+			start:     time.Date(2019, 7, 5, 1, 30, 0, 0, chicago),
+		},
+		{
+			// Start from the first scheduled departure on the active service date
+			trip:      &transit_realtime.TripDescriptor{TripId: proto.String("t1")},
+			reference: time.Date(2019, 7, 5, 0, 5, 0, 0, chicago),
+			date:      time.Date(2019, 7, 4, 0, 0, 0, 0, chicago),
+			start:     time.Date(2019, 7, 4, 23, 50, 0, 0, chicago),
+		},
+	} {
+		event := f.TransformVehicle(&transit_realtime.VehiclePosition{
+			Trip:      test.trip,
+			Timestamp: proto.Uint64(uint64(test.reference.Unix())),
+		})
+		if got, _ := event.GetValue("trip.start_date"); got == nil || !got.(time.Time).Equal(test.date) {
+			t.Errorf("trip.start_date = %v, want %v", got, test.date)
+		}
+		if got, _ := event.GetValue("trip.start_time"); got != test.start.UTC() {
+			t.Errorf("trip.start_time = %v, want %v", got, test.start.UTC())
+		}
+	}
+
+	event := f.TransformVehicle(&transit_realtime.VehiclePosition{
+		Trip: &transit_realtime.TripDescriptor{TripId: proto.String("t1"), StartTime: proto.String("8:30")},
+	})
+	if _, err := event.GetValue("trip.start_time"); err == nil {
+		t.Error("unexpected start time for an invalid gtfs time")
+	}
+}
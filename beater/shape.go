@@ -0,0 +1,156 @@
+package beater
+
+import (
+	"math"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//shapeAmbiguity how much further than the nearest segment another segment may
+//be and still be preferred, for shapes that pass the same place twice
+const shapeAmbiguity = 20.0
+
+//shapeLine a shape with the distance travelled in meters at each of its points
+type shapeLine struct {
+	points   []GeoPoint
+	distance []float64
+}
+
+//Length the length of the shape in meters
+func (l *shapeLine) Length() float64 {
+	if len(l.distance) == 0 {
+		return 0
+	}
+	return l.distance[len(l.distance)-1]
+}
+
+//shapeProjection where a position lies along a shape
+type shapeProjection struct {
+	along  float64
+	offset float64
+}
+
+//shapeProgress how far along the shape of its trip a vehicle was last seen,
+//and earlier on the same trip
+type shapeProgress struct {
+	tripID    string
+	along     float64
+	timestamp time.Time
+	trip      *transit_realtime.TripDescriptor
+	vehicle   *transit_realtime.VehicleDescriptor
+	history   []progressSample
+}
+
+//resolveShapes measures the sorted points of every shape
+func (s *Static) resolveShapes() {
+	s.shapeLines = make(map[string]*shapeLine, len(s.Shapes))
+	for shapeID, points := range s.Shapes {
+		line := &shapeLine{
+			points:   make([]GeoPoint, len(points)),
+			distance: make([]float64, len(points)),
+		}
+		for i, point := range points {
+			line.points[i] = point.Position
+			if i > 0 {
+				previous := points[i-1].Position
+				line.distance[i] = line.distance[i-1] + haversine(float64(previous.Lat), float64(previous.Long), float64(point.Position.Lat), float64(point.Position.Long))
+			}
+		}
+		s.shapeLines[shapeID] = line
+	}
+}
+
+//Project finds the segment of the shape nearest to the position. Among
+//segments about as near, the one closest to the hint distance is preferred.
+func (l *shapeLine) Project(lat, lon float64, hint *float64) (shapeProjection, bool) {
+	if len(l.points) < 2 {
+		return shapeProjection{}, false
+	}
+	projections := make([]shapeProjection, 0, len(l.points)-1)
+	nearest := math.Inf(1)
+	for i := 1; i < len(l.points); i++ {
+		t, offset := projectOnSegment(l.points[i-1], l.points[i], lat, lon)
+		projection := shapeProjection{
+			along:  l.distance[i-1] + t*(l.distance[i]-l.distance[i-1]),
+			offset: offset,
+		}
+		projections = append(projections, projection)
+		nearest = math.Min(nearest, offset)
+	}
+	best := shapeProjection{offset: math.Inf(1)}
+	for _, projection := range projections {
+		if projection.offset > nearest+shapeAmbiguity {
+			continue
+		}
+		if hint == nil {
+			if projection.offset < best.offset {
+				best = projection
+			}
+		} else if math.IsInf(best.offset, 1) || math.Abs(projection.along-*hint) < math.Abs(best.along-*hint) {
+			best = projection
+		}
+	}
+	return best, true
+}
+
+//projectOnSegment the fraction of the segment from a to b nearest to the
+//position, and the distance in meters between them, on a local flat projection
+func projectOnSegment(a, b GeoPoint, lat, lon float64) (float64, float64) {
+	metersPerDegree := earthRadiusMeters * math.Pi / 180
+	scale := math.Cos(float64(a.Lat) * math.Pi / 180)
+	bx := (float64(b.Long) - float64(a.Long)) * scale * metersPerDegree
+	by := (float64(b.Lat) - float64(a.Lat)) * metersPerDegree
+	px := (lon - float64(a.Long)) * scale * metersPerDegree
+	py := (lat - float64(a.Lat)) * metersPerDegree
+	t := 0.0
+	if length := bx*bx + by*by; length > 0 {
+		t = math.Max(0, math.Min(1, (px*bx+py*by)/length))
+	}
+	return t, math.Hypot(px-t*bx, py-t*by)
+}
+
+//addShapeProgress snaps the vehicle onto the shape of its trip, adding how far
+//along the shape it is and how far it strays from it
+func (f *Feed) addShapeProgress(vehicle *transit_realtime.VehiclePosition, e *beat.Event) {
+	position := vehicle.GetPosition()
+	tripID := vehicle.GetTrip().GetTripId()
+	if position == nil || position.Latitude == nil || position.Longitude == nil || tripID == "" {
+		return
+	}
+	static := f.Static()
+	line, ok := static.shapeLines[static.Trips[tripID].ShapeID]
+	if !ok {
+		return
+	}
+	key := vehicleKey(vehicle)
+	var hint *float64
+	previous, tracked := f.progress[key]
+	if tracked && previous.tripID == tripID {
+		hint = &previous.along
+	}
+	projection, ok := line.Project(float64(*position.Latitude), float64(*position.Longitude), hint)
+	if !ok {
+		return
+	}
+	if key != "" && (!tracked || e.Timestamp.After(previous.timestamp) || previous.tripID != tripID) {
+		progress := shapeProgress{
+			tripID:    tripID,
+			along:     projection.along,
+			timestamp: e.Timestamp,
+			trip:      vehicle.Trip,
+			vehicle:   vehicle.Vehicle,
+		}
+		f.progress[key] = progress.withSample(previous, tracked)
+	}
+	e.PutValue("shape_dist_traveled", projection.along)
+	if length := line.Length(); length > 0 {
+		e.PutValue("trip.progress_pct", 100*projection.along/length)
+	}
+	e.PutValue("off_route_meters", projection.offset)
+	if f.config.OffRouteDistance > 0 {
+		e.PutValue("off_route", projection.offset > f.config.OffRouteDistance)
+	}
+}
@@ -0,0 +1,67 @@
+// +build !integration
+
+package beater
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//shapeTestStatic an out and back shape of about 2 x 1112 meters north of the origin
+func shapeTestStatic() *Static {
+	s := &Static{
+		Trips: map[string]ScheduledTrip{"trip": {ShapeID: "shape"}},
+		Shapes: map[string][]ShapePoint{"shape": {
+			{Position: GeoPoint{Lat: 29.40, Long: -98.50}, Sequence: 1},
+			{Position: GeoPoint{Lat: 29.41, Long: -98.50}, Sequence: 2},
+			{Position: GeoPoint{Lat: 29.41, Long: -98.5001}, Sequence: 3},
+			{Position: GeoPoint{Lat: 29.40, Long: -98.5001}, Sequence: 4},
+		}},
+	}
+	s.resolveShapes()
+	return s
+}
+
+func TestShapeProjection(t *testing.T) {
+	line := shapeTestStatic().shapeLines["shape"]
+	projection, ok := line.Project(29.405, -98.4995, nil)
+	if !ok {
+		t.Fatal("expected a projection")
+	}
+	if math.Abs(projection.along-556) > 2 || math.Abs(projection.offset-48.5) > 1 {
+		t.Errorf("unexpected projection %+v", projection)
+	}
+	// Both legs are about as near, the way back is closest to the last known progress
+	back := 1700.0
+	projection, _ = line.Project(29.405, -98.50005, &back)
+	if math.Abs(projection.along-1678) > 2 {
+		t.Errorf("expected the way back, got %+v", projection)
+	}
+}
+
+func TestTransformVehicleAddsShapeProgress(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test", OffRouteDistance: 100}, NewStaticBundle(config.StaticConfig{}, shapeTestStatic()))
+	position := vehiclePosition("1", 29.405, -98.50, time.Now())
+	position.Trip = &transit_realtime.TripDescriptor{TripId: proto.String("trip")}
+	event := f.TransformVehicle(position)
+	if progress, _ := event.GetValue("trip.progress_pct"); progress == nil || math.Abs(progress.(float64)-25) > 0.5 {
+		t.Errorf("unexpected progress %v", progress)
+	}
+	if offRoute, _ := event.GetValue("off_route"); offRoute != false {
+		t.Errorf("expected the vehicle on route, got %v", offRoute)
+	}
+	position.Position.Longitude = proto.Float32(-98.49)
+	event = f.TransformVehicle(position)
+	if offRoute, _ := event.GetValue("off_route"); offRoute != true {
+		t.Errorf("expected the vehicle off route, got %v", offRoute)
+	}
+	if distance, _ := event.GetValue("off_route_meters"); distance.(float64) < 900 {
+		t.Errorf("unexpected off route distance %v", distance)
+	}
+}
@@ -0,0 +1,84 @@
+package beater
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//feedState the current entities of a feed keyed by entity id
+type feedState struct {
+	entities map[string]*transit_realtime.FeedEntity
+}
+
+func newFeedState() *feedState {
+	return &feedState{
+		entities: map[string]*transit_realtime.FeedEntity{},
+	}
+}
+
+//Apply updates the state with a feed message, returning the last known
+//version of every entity it deleted. A full dataset replaces the state while
+//a differential update only touches the entities it lists.
+func (s *feedState) Apply(message *transit_realtime.FeedMessage) []*transit_realtime.FeedEntity {
+	deleted := []*transit_realtime.FeedEntity{}
+	previous := s.entities
+	fullDataset := message.GetHeader().GetIncrementality() == transit_realtime.FeedHeader_FULL_DATASET
+	if fullDataset {
+		s.entities = make(map[string]*transit_realtime.FeedEntity, len(message.GetEntity()))
+	}
+	for i, entity := range message.GetEntity() {
+		id := entity.GetId()
+		if id == "" {
+			if !fullDataset {
+				logp.Warn("Ignoring differential update of an entity without id")
+				continue
+			}
+			// Entities without an id cannot be updated later, keep them until the next full dataset
+			id = fmt.Sprintf("#%d", i)
+		}
+		if entity.GetIsDeleted() {
+			if last, ok := previous[id]; ok {
+				deleted = append(deleted, last)
+			}
+			delete(s.entities, id)
+			continue
+		}
+		s.entities[id] = entity
+	}
+	if fullDataset {
+		for id, last := range previous {
+			if _, ok := s.entities[id]; !ok && last.Id != nil && !containsEntity(deleted, last) {
+				deleted = append(deleted, last)
+			}
+		}
+	}
+	sortEntities(deleted)
+	return deleted
+}
+
+//Entities the current entities ordered by id
+func (s *feedState) Entities() []*transit_realtime.FeedEntity {
+	entities := make([]*transit_realtime.FeedEntity, 0, len(s.entities))
+	for _, entity := range s.entities {
+		entities = append(entities, entity)
+	}
+	sortEntities(entities)
+	return entities
+}
+
+func containsEntity(entities []*transit_realtime.FeedEntity, entity *transit_realtime.FeedEntity) bool {
+	for _, e := range entities {
+		if e == entity {
+			return true
+		}
+	}
+	return false
+}
+
+func sortEntities(entities []*transit_realtime.FeedEntity) {
+	sort.Slice(entities, func(i, j int) bool { return entities[i].GetId() < entities[j].GetId() })
+}
@@ -0,0 +1,104 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func vehicleEntity(id string, vehicleID string) *transit_realtime.FeedEntity {
+	return &transit_realtime.FeedEntity{
+		Id: proto.String(id),
+		Vehicle: &transit_realtime.VehiclePosition{
+			Vehicle: &transit_realtime.VehicleDescriptor{Id: proto.String(vehicleID)},
+		},
+	}
+}
+
+func feedMessage(incrementality transit_realtime.FeedHeader_Incrementality, entities ...*transit_realtime.FeedEntity) *transit_realtime.FeedMessage {
+	return &transit_realtime.FeedMessage{
+		Header: &transit_realtime.FeedHeader{
+			GtfsRealtimeVersion: proto.String("2.0"),
+			Incrementality:      incrementality.Enum(),
+		},
+		Entity: entities,
+	}
+}
+
+func entityIDs(entities []*transit_realtime.FeedEntity) []string {
+	ids := []string{}
+	for _, entity := range entities {
+		ids = append(ids, entity.GetId())
+	}
+	return ids
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFeedStateFullDataset(t *testing.T) {
+	state := newFeedState()
+	deleted := state.Apply(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"), vehicleEntity("2", "b")))
+	if len(deleted) != 0 {
+		t.Errorf("unexpected deletions %v", entityIDs(deleted))
+	}
+	deleted = state.Apply(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("2", "b"), vehicleEntity("3", "c")))
+	if !equalIDs(entityIDs(deleted), []string{"1"}) {
+		t.Errorf("unexpected deletions %v", entityIDs(deleted))
+	}
+	if !equalIDs(entityIDs(state.Entities()), []string{"2", "3"}) {
+		t.Errorf("unexpected state %v", entityIDs(state.Entities()))
+	}
+}
+
+func TestFeedStateDifferential(t *testing.T) {
+	state := newFeedState()
+	state.Apply(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"), vehicleEntity("2", "b")))
+	removed := vehicleEntity("1", "")
+	removed.IsDeleted = proto.Bool(true)
+	updated := vehicleEntity("2", "b2")
+	deleted := state.Apply(feedMessage(transit_realtime.FeedHeader_DIFFERENTIAL, removed, updated, vehicleEntity("3", "c")))
+	if !equalIDs(entityIDs(deleted), []string{"1"}) || deleted[0].GetVehicle().GetVehicle().GetId() != "a" {
+		t.Errorf("unexpected deletions %v", deleted)
+	}
+	entities := state.Entities()
+	if !equalIDs(entityIDs(entities), []string{"2", "3"}) || entities[0] != updated {
+		t.Errorf("unexpected state %v", entities)
+	}
+}
+
+func TestProcessFeedPublishesDeletions(t *testing.T) {
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{}))
+	f.ProcessFeed(feedMessage(transit_realtime.FeedHeader_FULL_DATASET, vehicleEntity("1", "a"), vehicleEntity("2", "b")))
+	removed := vehicleEntity("1", "")
+	removed.IsDeleted = proto.Bool(true)
+	events := f.ProcessFeed(feedMessage(transit_realtime.FeedHeader_DIFFERENTIAL, removed))
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	for key, want := range map[string]interface{}{
+		"type":        "deletion",
+		"entity_id":   "1",
+		"entity_type": "vehicle",
+		"vehicle.id":  "a",
+		"feed.name":   "test",
+	} {
+		if got, _ := events[1].GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+}
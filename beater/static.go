@@ -0,0 +1,509 @@
+package beater
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+//ServiceTime seconds since "noon minus 12h" of a service day, may exceed 24 hours
+type ServiceTime int32
+
+const noServiceTime ServiceTime = -1
+
+//Valid whether the time was set
+func (t ServiceTime) Valid() bool {
+	return t >= 0
+}
+
+//Agency static gtfs agency definition
+type Agency struct {
+	ID       string
+	Name     string
+	URL      string
+	Timezone string
+	Lang     string
+	Phone    string
+	FareURL  string
+	Email    string
+}
+
+//Route static gtfs route definition
+type Route struct {
+	ID          string
+	AgencyID    string
+	ShortName   string
+	LongName    string
+	Description string
+	Type        int
+	URL         string
+	Color       string
+	TextColor   string
+	SortOrder   int
+}
+
+//ScheduledTrip static gtfs trip definition
+type ScheduledTrip struct {
+	ID                   string
+	RouteID              string
+	ServiceID            string
+	Headsign             string
+	ShortName            string
+	DirectionID          *uint32
+	BlockID              string
+	ShapeID              string
+	WheelchairAccessible int
+	BikesAllowed         int
+}
+
+//StopTime static gtfs scheduled stop of a trip
+type StopTime struct {
+	TripID            string
+	ArrivalTime       ServiceTime
+	DepartureTime     ServiceTime
+	StopID            string
+	StopSequence      uint32
+	StopHeadsign      string
+	PickupType        int
+	DropOffType       int
+	ShapeDistTraveled *float64
+	Timepoint         int
+}
+
+//Calendar static gtfs weekly service definition
+type Calendar struct {
+	ServiceID string
+	Days      [7]bool
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+//CalendarDate static gtfs service exception
+type CalendarDate struct {
+	ServiceID     string
+	Date          time.Time
+	ExceptionType int
+}
+
+//FareAttribute static gtfs fare definition
+type FareAttribute struct {
+	ID               string
+	Price            float64
+	CurrencyType     string
+	PaymentMethod    int
+	Transfers        *int
+	AgencyID         string
+	TransferDuration int
+}
+
+//FareRule static gtfs fare rule
+type FareRule struct {
+	FareID        string
+	RouteID       string
+	OriginID      string
+	DestinationID string
+	ContainsID    string
+}
+
+//ShapePoint static gtfs point of a shape
+type ShapePoint struct {
+	Position     GeoPoint
+	Sequence     uint32
+	DistTraveled *float64
+}
+
+//Frequency static gtfs headway based service of a trip
+type Frequency struct {
+	TripID      string
+	StartTime   ServiceTime
+	EndTime     ServiceTime
+	HeadwaySecs int
+	ExactTimes  int
+}
+
+//Transfer static gtfs transfer rule between stops
+type Transfer struct {
+	FromStopID      string
+	ToStopID        string
+	TransferType    int
+	MinTransferTime int
+}
+
+//FeedInfo static gtfs feed publisher information
+type FeedInfo struct {
+	PublisherName string
+	PublisherURL  string
+	Lang          string
+	StartDate     time.Time
+	EndDate       time.Time
+	Version       string
+	ContactEmail  string
+	ContactURL    string
+}
+
+//Static in memory model of a static gtfs bundle
+type Static struct {
+	Agencies       map[string]Agency
+	Stops          map[string]Stop
+	Routes         map[string]Route
+	Trips          map[string]ScheduledTrip
+	StopTimes      map[string][]StopTime
+	Calendars      map[string]Calendar
+	CalendarDates  map[string][]CalendarDate
+	FareAttributes map[string]FareAttribute
+	FareRules      []FareRule
+	Shapes         map[string][]ShapePoint
+	Frequencies    map[string][]Frequency
+	Transfers      []Transfer
+	FeedInfo       *FeedInfo
+	locations      map[string]*time.Location
+	shapeLines     map[string]*shapeLine
+}
+
+//IsServiceActive whether the service runs on the given service date
+func (s *Static) IsServiceActive(serviceID string, date time.Time) bool {
+	year, month, day := date.Date()
+	date = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	for _, exception := range s.CalendarDates[serviceID] {
+		if exception.Date.Equal(date) {
+			return exception.ExceptionType == 1
+		}
+	}
+	calendar, ok := s.Calendars[serviceID]
+	if !ok || date.Before(calendar.StartDate) || date.After(calendar.EndDate) {
+		return false
+	}
+	return calendar.Days[date.Weekday()]
+}
+
+//LoadStatic loads every file of the static gtfs bundle. Only stops are required,
+//missing files leave their tables empty.
+func LoadStatic(c config.StaticConfig) (*Static, error) {
+	s, _, err := loadStatic(c, staticVersion{})
+	return s, err
+}
+
+//loadStatic loads the static gtfs bundle unless it is still at the previous version
+func loadStatic(c config.StaticConfig, previous staticVersion) (*Static, staticVersion, error) {
+	var files staticFiles
+	var version staticVersion
+	if c.Source != "" {
+		var err error
+		if files, version, err = openStaticSource(c.Source, previous); err != nil {
+			if err == errStaticNotModified {
+				return nil, previous, err
+			}
+			return nil, previous, fmt.Errorf("Error opening static gtfs source %s: %v", c.Source, err)
+		}
+	} else {
+		for _, fileName := range staticFileNames(c) {
+			if info, err := os.Stat(fileName); err == nil && info.ModTime().After(version.ModTime) {
+				version.ModTime = info.ModTime()
+			}
+		}
+		if previous.unchanged(version.ModTime) {
+			return nil, previous, errStaticNotModified
+		}
+	}
+	s := &Static{
+		Agencies:       map[string]Agency{},
+		Stops:          map[string]Stop{},
+		Routes:         map[string]Route{},
+		Trips:          map[string]ScheduledTrip{},
+		StopTimes:      map[string][]StopTime{},
+		Calendars:      map[string]Calendar{},
+		CalendarDates:  map[string][]CalendarDate{},
+		FareAttributes: map[string]FareAttribute{},
+		Shapes:         map[string][]ShapePoint{},
+		Frequencies:    map[string][]Frequency{},
+	}
+	loaders := []struct {
+		name     string
+		fileName string
+		optional bool
+		load     func(r *csvReader)
+		required []string
+	}{
+		{"stops.txt", c.Stops, false, s.addStop, []string{"stop_id"}},
+		{"agency.txt", c.Agency, true, s.addAgency, []string{"agency_name"}},
+		{"routes.txt", c.Routes, true, s.addRoute, []string{"route_id", "route_type"}},
+		{"trips.txt", c.Trips, true, s.addTrip, []string{"route_id", "service_id", "trip_id"}},
+		{"stop_times.txt", c.StopTimes, true, s.addStopTime, []string{"trip_id", "stop_id", "stop_sequence"}},
+		{"calendar.txt", c.Calendar, true, s.addCalendar, []string{"service_id", "start_date", "end_date"}},
+		{"calendar_dates.txt", c.CalendarDates, true, s.addCalendarDate, []string{"service_id", "date", "exception_type"}},
+		{"fare_attributes.txt", c.FareAttributes, true, s.addFareAttribute, []string{"fare_id", "price", "currency_type", "payment_method"}},
+		{"fare_rules.txt", c.FareRules, true, s.addFareRule, []string{"fare_id"}},
+		{"shapes.txt", c.Shapes, true, s.addShapePoint, []string{"shape_id", "shape_pt_lat", "shape_pt_lon", "shape_pt_sequence"}},
+		{"frequencies.txt", c.Frequency, true, s.addFrequency, []string{"trip_id", "start_time", "end_time", "headway_secs"}},
+		{"transfers.txt", c.Transfers, true, s.addTransfer, []string{"from_stop_id", "to_stop_id"}},
+		{"feed_info.txt", c.FeedInfo, true, s.addFeedInfo, []string{"feed_publisher_name"}},
+	}
+	for _, loader := range loaders {
+		var f io.ReadCloser
+		var err error
+		name := loader.name
+		if files != nil {
+			f, err = files.Open(name)
+		} else if loader.fileName != "" || !loader.optional {
+			name = loader.fileName
+			f, err = os.Open(name)
+		} else {
+			continue
+		}
+		if err != nil {
+			if os.IsNotExist(err) && loader.optional {
+				logp.Warn("Static gtfs file %s not found, skipping", name)
+				continue
+			}
+			return nil, previous, err
+		}
+		err = readCSV(name, f, loader.load, loader.required...)
+		f.Close()
+		if err != nil {
+			return nil, previous, err
+		}
+	}
+	for tripID, stopTimes := range s.StopTimes {
+		sort.Slice(stopTimes, func(i, j int) bool { return stopTimes[i].StopSequence < stopTimes[j].StopSequence })
+		s.StopTimes[tripID] = stopTimes
+	}
+	for shapeID, points := range s.Shapes {
+		sort.Slice(points, func(i, j int) bool { return points[i].Sequence < points[j].Sequence })
+		s.Shapes[shapeID] = points
+	}
+	s.resolveLocations()
+	s.resolveShapes()
+	logp.Info("Loaded static gtfs: %d agencies, %d stops, %d routes, %d trips, %d shapes",
+		len(s.Agencies), len(s.Stops), len(s.Routes), len(s.Trips), len(s.Shapes))
+	return s, version, nil
+}
+
+func staticFileNames(c config.StaticConfig) []string {
+	return []string{c.Stops, c.Agency, c.Routes, c.Trips, c.StopTimes, c.Calendar, c.CalendarDates,
+		c.FareAttributes, c.FareRules, c.Shapes, c.Frequency, c.Transfers, c.FeedInfo}
+}
+
+//readCSV calls load for every row of the file, stopping at the first invalid row
+func readCSV(name string, f io.Reader, load func(r *csvReader), required ...string) error {
+	r, err := newCSVReader(name, f, required...)
+	if err != nil {
+		return err
+	}
+	for {
+		ok, err := r.Next()
+		if err != nil || !ok {
+			return err
+		}
+		load(r)
+		if err := r.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func parseGTFSDate(value string) (time.Time, error) {
+	return time.Parse("20060102", value)
+}
+
+//parseServiceTime parses a gtfs HH:MM:SS time, hours may exceed 23
+func parseServiceTime(value string) (ServiceTime, error) {
+	if value == "" {
+		return noServiceTime, nil
+	}
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 {
+		return noServiceTime, fmt.Errorf("invalid gtfs time %s", value)
+	}
+	var seconds int
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return noServiceTime, fmt.Errorf("invalid gtfs time %s", value)
+		}
+		seconds = seconds*60 + n
+	}
+	return ServiceTime(seconds), nil
+}
+
+func (s *Static) addStop(r *csvReader) {
+	stop := Stop{
+		ID:                r.String("stop_id"),
+		Code:              r.String("stop_code"),
+		Name:              r.String("stop_name"),
+		Description:       r.String("stop_desc"),
+		ZoneID:            r.String("zone_id"),
+		URL:               r.String("stop_url"),
+		LocationType:      r.String("location_type"),
+		ParentStation:     r.String("parent_station"),
+		Timezone:          r.String("stop_timezone"),
+		WheelcharBoarding: uint64(r.Uint32("wheelchair_boarding")),
+		Position: GeoPoint{
+			Lat:  float32(r.Float("stop_lat")),
+			Long: float32(r.Float("stop_lon")),
+		},
+	}
+	s.Stops[stop.ID] = stop
+}
+
+func (s *Static) addAgency(r *csvReader) {
+	agency := Agency{
+		ID:       r.String("agency_id"),
+		Name:     r.String("agency_name"),
+		URL:      r.String("agency_url"),
+		Timezone: r.String("agency_timezone"),
+		Lang:     r.String("agency_lang"),
+		Phone:    r.String("agency_phone"),
+		FareURL:  r.String("agency_fare_url"),
+		Email:    r.String("agency_email"),
+	}
+	s.Agencies[agency.ID] = agency
+}
+
+func (s *Static) addRoute(r *csvReader) {
+	route := Route{
+		ID:          r.String("route_id"),
+		AgencyID:    r.String("agency_id"),
+		ShortName:   r.String("route_short_name"),
+		LongName:    r.String("route_long_name"),
+		Description: r.String("route_desc"),
+		Type:        r.Int("route_type"),
+		URL:         r.String("route_url"),
+		Color:       r.String("route_color"),
+		TextColor:   r.String("route_text_color"),
+		SortOrder:   r.Int("route_sort_order"),
+	}
+	s.Routes[route.ID] = route
+}
+
+func (s *Static) addTrip(r *csvReader) {
+	trip := ScheduledTrip{
+		ID:                   r.String("trip_id"),
+		RouteID:              r.String("route_id"),
+		ServiceID:            r.String("service_id"),
+		Headsign:             r.String("trip_headsign"),
+		ShortName:            r.String("trip_short_name"),
+		DirectionID:          r.OptionalUint32("direction_id"),
+		BlockID:              r.String("block_id"),
+		ShapeID:              r.String("shape_id"),
+		WheelchairAccessible: r.Int("wheelchair_accessible"),
+		BikesAllowed:         r.Int("bikes_allowed"),
+	}
+	s.Trips[trip.ID] = trip
+}
+
+func (s *Static) addStopTime(r *csvReader) {
+	stopTime := StopTime{
+		TripID:            r.String("trip_id"),
+		ArrivalTime:       r.ServiceTime("arrival_time"),
+		DepartureTime:     r.ServiceTime("departure_time"),
+		StopID:            r.String("stop_id"),
+		StopSequence:      r.Uint32("stop_sequence"),
+		StopHeadsign:      r.String("stop_headsign"),
+		PickupType:        r.Int("pickup_type"),
+		DropOffType:       r.Int("drop_off_type"),
+		ShapeDistTraveled: r.OptionalFloat("shape_dist_traveled"),
+		Timepoint:         r.Int("timepoint"),
+	}
+	s.StopTimes[stopTime.TripID] = append(s.StopTimes[stopTime.TripID], stopTime)
+}
+
+func (s *Static) addCalendar(r *csvReader) {
+	calendar := Calendar{
+		ServiceID: r.String("service_id"),
+		StartDate: r.Date("start_date"),
+		EndDate:   r.Date("end_date"),
+	}
+	days := []string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}
+	for i, day := range days {
+		calendar.Days[i] = r.Int(day) == 1
+	}
+	s.Calendars[calendar.ServiceID] = calendar
+}
+
+func (s *Static) addCalendarDate(r *csvReader) {
+	calendarDate := CalendarDate{
+		ServiceID:     r.String("service_id"),
+		Date:          r.Date("date"),
+		ExceptionType: r.Int("exception_type"),
+	}
+	s.CalendarDates[calendarDate.ServiceID] = append(s.CalendarDates[calendarDate.ServiceID], calendarDate)
+}
+
+func (s *Static) addFareAttribute(r *csvReader) {
+	fare := FareAttribute{
+		ID:               r.String("fare_id"),
+		Price:            r.Float("price"),
+		CurrencyType:     r.String("currency_type"),
+		PaymentMethod:    r.Int("payment_method"),
+		AgencyID:         r.String("agency_id"),
+		TransferDuration: r.Int("transfer_duration"),
+	}
+	if r.String("transfers") != "" {
+		transfers := r.Int("transfers")
+		fare.Transfers = &transfers
+	}
+	s.FareAttributes[fare.ID] = fare
+}
+
+func (s *Static) addFareRule(r *csvReader) {
+	s.FareRules = append(s.FareRules, FareRule{
+		FareID:        r.String("fare_id"),
+		RouteID:       r.String("route_id"),
+		OriginID:      r.String("origin_id"),
+		DestinationID: r.String("destination_id"),
+		ContainsID:    r.String("contains_id"),
+	})
+}
+
+func (s *Static) addShapePoint(r *csvReader) {
+	point := ShapePoint{
+		Position: GeoPoint{
+			Lat:  float32(r.Float("shape_pt_lat")),
+			Long: float32(r.Float("shape_pt_lon")),
+		},
+		Sequence:     r.Uint32("shape_pt_sequence"),
+		DistTraveled: r.OptionalFloat("shape_dist_traveled"),
+	}
+	s.Shapes[r.String("shape_id")] = append(s.Shapes[r.String("shape_id")], point)
+}
+
+func (s *Static) addFrequency(r *csvReader) {
+	frequency := Frequency{
+		TripID:      r.String("trip_id"),
+		StartTime:   r.ServiceTime("start_time"),
+		EndTime:     r.ServiceTime("end_time"),
+		HeadwaySecs: r.Int("headway_secs"),
+		ExactTimes:  r.Int("exact_times"),
+	}
+	s.Frequencies[frequency.TripID] = append(s.Frequencies[frequency.TripID], frequency)
+}
+
+func (s *Static) addTransfer(r *csvReader) {
+	s.Transfers = append(s.Transfers, Transfer{
+		FromStopID:      r.String("from_stop_id"),
+		ToStopID:        r.String("to_stop_id"),
+		TransferType:    r.Int("transfer_type"),
+		MinTransferTime: r.Int("min_transfer_time"),
+	})
+}
+
+func (s *Static) addFeedInfo(r *csvReader) {
+	s.FeedInfo = &FeedInfo{
+		PublisherName: r.String("feed_publisher_name"),
+		PublisherURL:  r.String("feed_publisher_url"),
+		Lang:          r.String("feed_lang"),
+		StartDate:     r.Date("feed_start_date"),
+		EndDate:       r.Date("feed_end_date"),
+		Version:       r.String("feed_version"),
+		ContactEmail:  r.String("feed_contact_email"),
+		ContactURL:    r.String("feed_contact_url"),
+	}
+}
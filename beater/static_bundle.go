@@ -0,0 +1,156 @@
+package beater
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+//StaticBundle a static gtfs bundle that is swapped atomically when reloaded
+type StaticBundle struct {
+	config  config.StaticConfig
+	current atomic.Value
+	mutex   sync.Mutex
+	version staticVersion
+	feeds   []string
+}
+
+//StaticChanges counts of the entries that changed between two versions of a table
+type StaticChanges struct {
+	Added   int
+	Removed int
+	Changed int
+}
+
+//NewStaticBundle wraps an already loaded static gtfs bundle
+func NewStaticBundle(c config.StaticConfig, static *Static) *StaticBundle {
+	b := &StaticBundle{config: c}
+	b.current.Store(static)
+	return b
+}
+
+//LoadStaticBundle loads the static gtfs bundle described by the configuration
+func LoadStaticBundle(c config.StaticConfig) (*StaticBundle, error) {
+	static, version, err := loadStatic(c, staticVersion{})
+	if err != nil {
+		return nil, err
+	}
+	b := NewStaticBundle(c, static)
+	b.version = version
+	return b, nil
+}
+
+//Get the current version of the static gtfs bundle
+func (b *StaticBundle) Get() *Static {
+	return b.current.Load().(*Static)
+}
+
+//Source describes where the bundle is loaded from
+func (b *StaticBundle) Source() string {
+	if b.config.Source != "" {
+		return b.config.Source
+	}
+	return b.config.Stops
+}
+
+//Reload fetches the bundle again and swaps it in when it has changed. The
+//returned changes are nil when the bundle is unchanged.
+func (b *StaticBundle) Reload() (map[string]StaticChanges, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	static, version, err := loadStatic(b.config, b.version)
+	if err == errStaticNotModified {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	changes := diffStatic(b.Get(), static)
+	b.current.Store(static)
+	b.version = version
+	return changes, nil
+}
+
+//Run reloads the bundle every refresh interval, publishing an event for every change until done is closed
+func (b *StaticBundle) Run(client beat.Client, done <-chan struct{}) {
+	logp.Info("Reloading static gtfs %s every %s", b.Source(), b.config.Refresh)
+	ticker := time.NewTicker(b.config.Refresh)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		changes, err := b.Reload()
+		if err != nil {
+			logp.Err("Error reloading static gtfs %s: %v", b.Source(), err)
+			continue
+		}
+		if changes == nil {
+			logp.Debug("gtfsbeat", "Static gtfs %s has not changed", b.Source())
+			continue
+		}
+		logp.Info("Reloaded static gtfs %s", b.Source())
+		client.Publish(b.reloadEvent(changes))
+	}
+}
+
+func (b *StaticBundle) reloadEvent(changes map[string]StaticChanges) beat.Event {
+	event := beat.Event{
+		Timestamp: time.Now(),
+		Fields:    common.MapStr{},
+	}
+	event.PutValue("type", "static_reload")
+	event.PutValue("feed.name", b.feeds)
+	event.PutValue("static.source", b.Source())
+	if feedInfo := b.Get().FeedInfo; feedInfo != nil {
+		addStringIfNotEmpty("static.version", feedInfo.Version, &event)
+	}
+	for table, change := range changes {
+		event.PutValue("static."+table+".added", change.Added)
+		event.PutValue("static."+table+".removed", change.Removed)
+		event.PutValue("static."+table+".changed", change.Changed)
+	}
+	return event
+}
+
+func diffStatic(previous, current *Static) map[string]StaticChanges {
+	return map[string]StaticChanges{
+		"agencies":   diffTable(previous.Agencies, current.Agencies),
+		"stops":      diffTable(previous.Stops, current.Stops),
+		"routes":     diffTable(previous.Routes, current.Routes),
+		"trips":      diffTable(previous.Trips, current.Trips),
+		"stop_times": diffTable(previous.StopTimes, current.StopTimes),
+		"calendars":  diffTable(previous.Calendars, current.Calendars),
+		"shapes":     diffTable(previous.Shapes, current.Shapes),
+	}
+}
+
+//diffTable compares two maps of the same type by key
+func diffTable(previous, current interface{}) StaticChanges {
+	changes := StaticChanges{}
+	previousTable := reflect.ValueOf(previous)
+	currentTable := reflect.ValueOf(current)
+	for _, key := range currentTable.MapKeys() {
+		previousValue := previousTable.MapIndex(key)
+		if !previousValue.IsValid() {
+			changes.Added++
+		} else if !reflect.DeepEqual(previousValue.Interface(), currentTable.MapIndex(key).Interface()) {
+			changes.Changed++
+		}
+	}
+	for _, key := range previousTable.MapKeys() {
+		if !currentTable.MapIndex(key).IsValid() {
+			changes.Removed++
+		}
+	}
+	return changes
+}
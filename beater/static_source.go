@@ -0,0 +1,144 @@
+package beater
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const staticDownloadTimeout = 5 * time.Minute
+
+//staticFiles opens the files of a static gtfs bundle by their standard name
+type staticFiles interface {
+	Open(name string) (io.ReadCloser, error)
+}
+
+//dirFiles a static gtfs bundle extracted in a local directory
+type dirFiles string
+
+func (d dirFiles) Open(name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(string(d), name))
+}
+
+//zipFiles a static gtfs bundle held in memory as a zip archive
+type zipFiles map[string]*zip.File
+
+func newZipFiles(data []byte) (zipFiles, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := zipFiles{}
+	for _, file := range archive.File {
+		// Some publishers nest the bundle within a folder of the archive
+		if !file.FileInfo().IsDir() {
+			files[path.Base(file.Name)] = file
+		}
+	}
+	return files, nil
+}
+
+func (z zipFiles) Open(name string) (io.ReadCloser, error) {
+	file, ok := z[name]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return file.Open()
+}
+
+//staticVersion identifies a revision of a static gtfs bundle
+type staticVersion struct {
+	ETag         string
+	LastModified string
+	ModTime      time.Time
+}
+
+var errStaticNotModified = errors.New("static gtfs not modified")
+
+//unchanged whether a local bundle modified at modTime is the previous version
+func (previous staticVersion) unchanged(modTime time.Time) bool {
+	return !previous.ModTime.IsZero() && !modTime.After(previous.ModTime)
+}
+
+//openStaticSource opens a local zip archive, a local directory or a zip archive served over http.
+//It returns errStaticNotModified when the source has not changed since the previous version.
+func openStaticSource(source string, previous staticVersion) (staticFiles, staticVersion, error) {
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		data, version, err := downloadStatic(source, previous)
+		if err != nil {
+			return nil, version, err
+		}
+		files, err := newZipFiles(data)
+		return files, version, err
+	}
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, previous, err
+	}
+	version := staticVersion{ModTime: info.ModTime()}
+	if info.IsDir() {
+		// The directory itself is not modified when the files within are rewritten
+		entries, err := ioutil.ReadDir(source)
+		if err != nil {
+			return nil, previous, err
+		}
+		for _, entry := range entries {
+			if entry.ModTime().After(version.ModTime) {
+				version.ModTime = entry.ModTime()
+			}
+		}
+		if previous.unchanged(version.ModTime) {
+			return nil, previous, errStaticNotModified
+		}
+		return dirFiles(source), version, nil
+	}
+	if previous.unchanged(version.ModTime) {
+		return nil, previous, errStaticNotModified
+	}
+	data, err := ioutil.ReadFile(source)
+	if err != nil {
+		return nil, previous, err
+	}
+	files, err := newZipFiles(data)
+	return files, version, err
+}
+
+func downloadStatic(url string, previous staticVersion) ([]byte, staticVersion, error) {
+	client := &http.Client{Timeout: staticDownloadTimeout}
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, previous, err
+	}
+	if previous.ETag != "" {
+		req.Header.Set("If-None-Match", previous.ETag)
+	}
+	if previous.LastModified != "" {
+		req.Header.Set("If-Modified-Since", previous.LastModified)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, previous, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, previous, errStaticNotModified
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, previous, fmt.Errorf("Error downloading static gtfs %s: %s", url, resp.Status)
+	}
+	version := staticVersion{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	return data, version, err
+}
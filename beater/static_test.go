@@ -0,0 +1,229 @@
+// +build !integration
+
+package beater
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/benwtrent/gtfsbeat/config"
+)
+
+func writeStaticFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "gtfsbeat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestLoadStatic(t *testing.T) {
+	dir := writeStaticFiles(t, map[string]string{
+		"stops.txt": "stop_id,stop_code,stop_name,stop_desc,stop_lat,stop_lon,zone_id,stop_url,location_type,parent_station,stop_timezone,wheelchair_boarding\n" +
+			"100,100,Main St,,29.42,-98.49,,,0,,,1\n",
+		"agency.txt": "agency_id,agency_name,agency_url,agency_timezone\n" +
+			"VIA,VIA Metropolitan Transit,http://www.viainfo.net,America/Chicago\n",
+		"routes.txt": "route_id,agency_id,route_short_name,route_long_name,route_type\n" +
+			"2,VIA,2,Blanco,3\n",
+		"trips.txt": "route_id,service_id,trip_id,trip_headsign,direction_id,shape_id\n" +
+			"2,WK,t1,Downtown,1,s1\n",
+		"stop_times.txt": "trip_id,arrival_time,departure_time,stop_id,stop_sequence\n" +
+			"t1,25:10:00,25:11:00,200,2\n" +
+			"t1,25:00:00,25:00:00,100,1\n",
+		"calendar.txt": "service_id,monday,tuesday,wednesday,thursday,friday,saturday,sunday,start_date,end_date\n" +
+			"WK,1,1,1,1,1,0,0,20190101,20191231\n",
+		"calendar_dates.txt": "service_id,date,exception_type\n" +
+			"WK,20190704,2\n" +
+			"WK,20190706,1\n",
+		"shapes.txt": "shape_id,shape_pt_lat,shape_pt_lon,shape_pt_sequence\n" +
+			"s1,29.43,-98.50,2\n" +
+			"s1,29.42,-98.49,1\n",
+	})
+	defer os.RemoveAll(dir)
+	c := config.StaticConfig{
+		Stops:         filepath.Join(dir, "stops.txt"),
+		Agency:        filepath.Join(dir, "agency.txt"),
+		Routes:        filepath.Join(dir, "routes.txt"),
+		Trips:         filepath.Join(dir, "trips.txt"),
+		StopTimes:     filepath.Join(dir, "stop_times.txt"),
+		Calendar:      filepath.Join(dir, "calendar.txt"),
+		CalendarDates: filepath.Join(dir, "calendar_dates.txt"),
+		Shapes:        filepath.Join(dir, "shapes.txt"),
+		Frequency:     filepath.Join(dir, "frequencies.txt"),
+	}
+
+	static, err := LoadStatic(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if static.Agencies["VIA"].Timezone != "America/Chicago" {
+		t.Errorf("unexpected agency %+v", static.Agencies["VIA"])
+	}
+	if route := static.Routes["2"]; route.LongName != "Blanco" || route.Type != 3 {
+		t.Errorf("unexpected route %+v", route)
+	}
+	if trip := static.Trips["t1"]; trip.Headsign != "Downtown" || trip.DirectionID == nil || *trip.DirectionID != 1 {
+		t.Errorf("unexpected trip %+v", trip)
+	}
+	stopTimes := static.StopTimes["t1"]
+	if len(stopTimes) != 2 || stopTimes[0].StopID != "100" || stopTimes[1].ArrivalTime != 25*3600+10*60 {
+		t.Errorf("unexpected stop times %+v", stopTimes)
+	}
+	if shape := static.Shapes["s1"]; len(shape) != 2 || shape[0].Sequence != 1 {
+		t.Errorf("unexpected shape %+v", shape)
+	}
+
+	for date, active := range map[string]bool{
+		"20190703": true,
+		"20190704": false,
+		"20190706": true,
+		"20190707": false,
+		"20200102": false,
+	} {
+		d, _ := time.Parse("20060102", date)
+		if static.IsServiceActive("WK", d) != active {
+			t.Errorf("expected service active on %s to be %v", date, active)
+		}
+	}
+}
+
+func TestParseServiceTime(t *testing.T) {
+	for value, expected := range map[string]ServiceTime{
+		"08:30:00": 8*3600 + 30*60,
+		"25:30:15": 25*3600 + 30*60 + 15,
+		"7:05:00":  7*3600 + 5*60,
+		"":         noServiceTime,
+	} {
+		parsed, err := parseServiceTime(value)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", value, err)
+		}
+		if parsed != expected {
+			t.Errorf("parseServiceTime(%s) = %d, want %d", value, parsed, expected)
+		}
+	}
+	for _, value := range []string{"8:30", "aa:00:00", "-1:00:00"} {
+		if _, err := parseServiceTime(value); err == nil {
+			t.Errorf("expected error for %s", value)
+		}
+	}
+}
+
+func TestLoadStaticFromSource(t *testing.T) {
+	files := map[string]string{
+		"stops.txt":  "stop_id,stop_name\n100,Main St\n",
+		"routes.txt": "route_id,route_type,route_long_name\n2,3,Blanco\n",
+	}
+	var archive bytes.Buffer
+	w := zip.NewWriter(&archive)
+	for name, contents := range files {
+		f, err := w.Create("google_transit/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte(contents))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	dir := writeStaticFiles(t, files)
+	defer os.RemoveAll(dir)
+	zipFile := filepath.Join(dir, "google_transit.zip")
+	if err := ioutil.WriteFile(zipFile, archive.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	for _, source := range []string{dir, zipFile, server.URL + "/google_transit.zip"} {
+		static, err := LoadStatic(config.StaticConfig{Source: source, Stops: "ignored.txt"})
+		if err != nil {
+			t.Errorf("%s: %v", source, err)
+			continue
+		}
+		if static.Stops["100"].Name != "Main St" || static.Routes["2"].LongName != "Blanco" {
+			t.Errorf("%s: unexpected static %+v", source, static)
+		}
+	}
+	if _, err := LoadStatic(config.StaticConfig{Source: filepath.Join(dir, "missing.zip")}); err == nil {
+		t.Error("expected missing source to fail")
+	}
+}
+
+func TestStaticBundleReload(t *testing.T) {
+	dir := writeStaticFiles(t, map[string]string{
+		"stops.txt":  "stop_id,stop_name\n100,Main St\n200,Broadway\n",
+		"routes.txt": "route_id,route_type\n2,3\n",
+	})
+	defer os.RemoveAll(dir)
+	bundle, err := LoadStaticBundle(config.StaticConfig{Source: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := bundle.Reload()
+	if err != nil || changes != nil {
+		t.Fatalf("expected unchanged bundle, got %v %v", changes, err)
+	}
+
+	stops := filepath.Join(dir, "stops.txt")
+	if err := ioutil.WriteFile(stops, []byte("stop_id,stop_name\n100,Main Street\n300,Houston\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(stops, later, later)
+	changes, err = bundle.Reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changes["stops"] != (StaticChanges{Added: 1, Removed: 1, Changed: 1}) {
+		t.Errorf("unexpected stop changes %+v", changes["stops"])
+	}
+	if changes["routes"] != (StaticChanges{}) {
+		t.Errorf("unexpected route changes %+v", changes["routes"])
+	}
+	if bundle.Get().Stops["100"].Name != "Main Street" {
+		t.Errorf("bundle was not swapped")
+	}
+}
+
+func TestStaticBundleReloadHonorsETag(t *testing.T) {
+	var archive bytes.Buffer
+	w := zip.NewWriter(&archive)
+	f, _ := w.Create("stops.txt")
+	f.Write([]byte("stop_id\n100\n"))
+	w.Close()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write(archive.Bytes())
+	}))
+	defer server.Close()
+
+	bundle, err := LoadStaticBundle(config.StaticConfig{Source: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, err := bundle.Reload()
+	if err != nil || changes != nil || requests != 2 {
+		t.Errorf("expected a not modified reload, got %v %v after %d requests", changes, err, requests)
+	}
+}
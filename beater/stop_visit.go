@@ -0,0 +1,155 @@
+package beater
+
+import (
+	"time"
+
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/common"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//stopVisitRadius how near in meters a vehicle approaching a stop has to be to count as arrived
+const stopVisitRadius = 30.0
+
+//stopVisit a vehicle observed at a stop, from the first observation at the stop
+type stopVisit struct {
+	trip    *transit_realtime.TripDescriptor
+	vehicle *transit_realtime.VehicleDescriptor
+	stopSeq *uint32
+	stopID  string
+	arrival time.Time
+}
+
+//vehicleVisits where a vehicle was last observed and the stop it is at, if any
+type vehicleVisits struct {
+	lastSeen time.Time
+	current  *stopVisit
+}
+
+//observedAt the time of the vehicle position, or else the time it was received
+func (f *Feed) observedAt(vehicle *transit_realtime.VehiclePosition) time.Time {
+	if vehicle.Timestamp != nil {
+		return time.Unix(int64(*vehicle.Timestamp), 0)
+	}
+	return f.now()
+}
+
+//stopVisitOf the stop the vehicle is at, when it reports being stopped at it or
+//is within the stop radius of the stop it is heading to
+func (f *Feed) stopVisitOf(vehicle *transit_realtime.VehiclePosition) *stopVisit {
+	static := f.Static()
+	stopID := vehicle.GetStopId()
+	if stopID == "" && vehicle.CurrentStopSequence != nil {
+		if stopTime := static.ScheduledStopTime(vehicle.GetTrip().GetTripId(), vehicle.CurrentStopSequence, nil); stopTime != nil {
+			stopID = stopTime.StopID
+		}
+	}
+	if stopID == "" && vehicle.CurrentStopSequence == nil {
+		return nil
+	}
+	at := vehicle.GetCurrentStatus() == transit_realtime.VehiclePosition_STOPPED_AT
+	if position := vehicle.GetPosition(); !at && position != nil {
+		if stop, ok := static.Stops[stopID]; ok && stop.Position.Lat != 0 {
+			distance := haversine(float64(position.GetLatitude()), float64(position.GetLongitude()), float64(stop.Position.Lat), float64(stop.Position.Long))
+			at = distance <= stopVisitRadius
+		}
+	}
+	if !at {
+		return nil
+	}
+	return &stopVisit{
+		trip:    vehicle.Trip,
+		vehicle: vehicle.Vehicle,
+		stopSeq: vehicle.CurrentStopSequence,
+		stopID:  stopID,
+	}
+}
+
+//sameStop whether two visits are of the same stop of the same trip
+func (v *stopVisit) sameStop(other *stopVisit) bool {
+	if v.trip.GetTripId() != other.trip.GetTripId() {
+		return false
+	}
+	if v.stopSeq != nil && other.stopSeq != nil {
+		return *v.stopSeq == *other.stopSeq
+	}
+	return v.stopID == other.stopID
+}
+
+//TrackStopVisit follows the vehicle from stop to stop, returning a stop_visit
+//event when it is observed to have left a stop. The departure is the first
+//observation after it left.
+func (f *Feed) TrackStopVisit(vehicle *transit_realtime.VehiclePosition) []beat.Event {
+	key := vehicleKey(vehicle)
+	if key == "" {
+		return nil
+	}
+	observedAt := f.observedAt(vehicle)
+	visits, ok := f.visits[key]
+	if !ok {
+		visits = &vehicleVisits{}
+		f.visits[key] = visits
+	} else if !observedAt.After(visits.lastSeen) {
+		// Already seen, as the same position is in every full dataset
+		return nil
+	}
+	visits.lastSeen = observedAt
+	events := []beat.Event{}
+	visit := f.stopVisitOf(vehicle)
+	if visits.current != nil && visit != nil && visits.current.sameStop(visit) {
+		return events
+	}
+	if visits.current != nil {
+		events = append(events, f.stopVisitEvent(visits.current, observedAt))
+		visits.current = nil
+	}
+	if visit != nil {
+		visit.arrival = observedAt
+		visits.current = visit
+	}
+	return events
+}
+
+//expireStopVisits ends the visits of vehicles no longer observed, such as at
+//the end of their last trip, without a departure
+func (f *Feed) expireStopVisits(now time.Time) []beat.Event {
+	events := []beat.Event{}
+	for key, visits := range f.visits {
+		if now.Sub(visits.lastSeen) <= maxDerivationGap {
+			continue
+		}
+		if visits.current != nil {
+			events = append(events, f.stopVisitEvent(visits.current, time.Time{}))
+		}
+		delete(f.visits, key)
+	}
+	return events
+}
+
+func (f *Feed) stopVisitEvent(visit *stopVisit, departure time.Time) beat.Event {
+	event := beat.Event{
+		Timestamp: visit.arrival,
+		Fields:    common.MapStr{},
+	}
+	event.PutValue("type", "stop_visit")
+	event.PutValue("feed.name", f.Name())
+	f.addScheduledTrip(visit.trip, &event)
+	f.addRoute(f.routeID(visit.trip), &event)
+	addVehicleDescriptors(visit.vehicle, &event)
+	addUint32IfNotNull("stop_seq", visit.stopSeq, &event)
+	if visit.stopID != "" {
+		f.addStopByID(visit.stopID, &event)
+	}
+	event.PutValue("visit.arrival", visit.arrival.UTC())
+	if !departure.IsZero() {
+		event.PutValue("visit.departure", departure.UTC())
+		event.PutValue("visit.dwell_seconds", int64(departure.Sub(visit.arrival)/time.Second))
+	}
+	var stopID *string
+	if visit.stopID != "" {
+		stopID = &visit.stopID
+	}
+	f.addSchedule(visit.trip, visit.stopSeq, stopID, visit.arrival, departure, visit.arrival, &event)
+	return event
+}
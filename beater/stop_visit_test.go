@@ -0,0 +1,75 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestTrackStopVisit(t *testing.T) {
+	static := scheduleTestStatic()
+	static.Stops = map[string]Stop{
+		"100": {ID: "100", Position: GeoPoint{Lat: 29.40, Long: -98.50}},
+		"200": {ID: "200", Position: GeoPoint{Lat: 29.41, Long: -98.50}},
+	}
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, static))
+	chicago, _ := time.LoadLocation("America/Chicago")
+	observe := func(at time.Time, status transit_realtime.VehiclePosition_VehicleStopStatus, seq uint32, lat float32) []interface{} {
+		position := vehiclePosition("bus", lat, -98.50, at)
+		position.Trip = &transit_realtime.TripDescriptor{TripId: proto.String("t1"), StartDate: proto.String("20190704")}
+		position.CurrentStatus = status.Enum()
+		position.CurrentStopSequence = proto.Uint32(seq)
+		events := f.TrackStopVisit(position)
+		types := []interface{}{}
+		for _, event := range events {
+			eventType, _ := event.GetValue("type")
+			types = append(types, eventType)
+		}
+		return types
+	}
+	// Thursday 2019-07-04, stop 100 is scheduled at 23:50
+	start := time.Date(2019, 7, 4, 23, 50, 0, 0, chicago)
+	observe(start, transit_realtime.VehiclePosition_INCOMING_AT, 1, 29.39)
+	// Within the radius of the stop it is heading to
+	observe(start.Add(time.Minute), transit_realtime.VehiclePosition_INCOMING_AT, 1, 29.4001)
+	observe(start.Add(2*time.Minute), transit_realtime.VehiclePosition_STOPPED_AT, 1, 29.40)
+	if types := observe(start.Add(2*time.Minute), transit_realtime.VehiclePosition_STOPPED_AT, 1, 29.40); len(types) != 0 {
+		t.Errorf("expected a republished position to be ignored, got %v", types)
+	}
+	position := vehiclePosition("bus", 29.402, -98.50, start.Add(3*time.Minute))
+	position.Trip = &transit_realtime.TripDescriptor{TripId: proto.String("t1"), StartDate: proto.String("20190704")}
+	position.CurrentStatus = transit_realtime.VehiclePosition_IN_TRANSIT_TO.Enum()
+	position.CurrentStopSequence = proto.Uint32(2)
+	events := f.TrackStopVisit(position)
+	if len(events) != 1 {
+		t.Fatalf("expected a stop visit, got %d events", len(events))
+	}
+	for key, want := range map[string]interface{}{
+		"type":                "stop_visit",
+		"stop.id":             "100",
+		"stop_seq":            uint32(1),
+		"visit.arrival":       start.Add(time.Minute).UTC(),
+		"visit.departure":     start.Add(3 * time.Minute).UTC(),
+		"visit.dwell_seconds": int64(120),
+		"delay_seconds":       int64(60),
+	} {
+		if got, _ := events[0].GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	observe(start.Add(20*time.Minute), transit_realtime.VehiclePosition_STOPPED_AT, 2, 29.41)
+	events = f.expireStopVisits(start.Add(40 * time.Minute))
+	if len(events) != 1 {
+		t.Fatalf("expected the last visit to expire, got %d events", len(events))
+	}
+	if departure, _ := events[0].GetValue("visit.departure"); departure != nil {
+		t.Errorf("expected no departure, got %v", departure)
+	}
+}
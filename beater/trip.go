@@ -0,0 +1,46 @@
+package beater
+
+import (
+	"github.com/elastic/beats/libbeat/beat"
+	"github.com/elastic/beats/libbeat/logp"
+
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+//addScheduledTrip adds the realtime trip descriptor together with the metadata
+//of the trip from trips.txt, flagging trips missing from the static schedule
+func (f *Feed) addScheduledTrip(trip *transit_realtime.TripDescriptor, e *beat.Event) {
+	if trip == nil {
+		return
+	}
+	addTrip(trip, e)
+	if serviceDate, startTime, ok := f.Static().StartTime(trip, e.Timestamp); ok {
+		e.PutValue("trip.start_date", serviceDate)
+		e.PutValue("trip.start_time", startTime.UTC())
+	}
+	if trip.TripId == nil {
+		return
+	}
+	scheduled, ok := f.Static().Trips[*trip.TripId]
+	e.PutValue("trip.in_schedule", ok)
+	if !ok {
+		if len(f.Static().Trips) > 0 {
+			f.recordUnknownTrip()
+		}
+		logp.Debug("gtfsbeat", "Trip %s of feed %s is not in the static schedule", *trip.TripId, f.Name())
+		return
+	}
+	if trip.RouteId == nil {
+		addStringIfNotEmpty("trip.route_id", scheduled.RouteID, e)
+	}
+	if trip.DirectionId == nil {
+		addUint32IfNotNull("trip.direction_id", scheduled.DirectionID, e)
+	}
+	addStringIfNotEmpty("trip.headsign", scheduled.Headsign, e)
+	addStringIfNotEmpty("trip.short_name", scheduled.ShortName, e)
+	addStringIfNotEmpty("trip.block_id", scheduled.BlockID, e)
+	addStringIfNotEmpty("trip.shape_id", scheduled.ShapeID, e)
+	addStringIfNotEmpty("trip.service_id", scheduled.ServiceID, e)
+	e.PutValue("trip.wheelchair_accessible", scheduled.WheelchairAccessible)
+	e.PutValue("trip.bikes_allowed", scheduled.BikesAllowed)
+}
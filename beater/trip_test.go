@@ -0,0 +1,70 @@
+// +build !integration
+
+package beater
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/benwtrent/gtfsbeat/config"
+	"github.com/benwtrent/gtfsbeat/transit_realtime"
+)
+
+func TestTripEnrichment(t *testing.T) {
+	direction := uint32(1)
+	f := NewFeed(config.FeedConfig{Name: "test"}, NewStaticBundle(config.StaticConfig{}, &Static{
+		Trips: map[string]ScheduledTrip{
+			"t1": {
+				ID:                   "t1",
+				RouteID:              "2",
+				ServiceID:            "WK",
+				Headsign:             "Downtown",
+				DirectionID:          &direction,
+				BlockID:              "b1",
+				ShapeID:              "s1",
+				WheelchairAccessible: 1,
+				BikesAllowed:         2,
+			},
+		},
+	}))
+
+	event := f.TransformVehicle(&transit_realtime.VehiclePosition{
+		Trip: &transit_realtime.TripDescriptor{TripId: proto.String("t1")},
+	})
+	for key, want := range map[string]interface{}{
+		"trip.in_schedule":           true,
+		"trip.route_id":              "2",
+		"trip.direction_id":          uint32(1),
+		"trip.headsign":              "Downtown",
+		"trip.block_id":              "b1",
+		"trip.shape_id":              "s1",
+		"trip.service_id":            "WK",
+		"trip.wheelchair_accessible": 1,
+		"trip.bikes_allowed":         2,
+	} {
+		if got, _ := event.GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+
+	event = f.TransformVehicle(&transit_realtime.VehiclePosition{
+		Trip: &transit_realtime.TripDescriptor{
+			TripId:      proto.String("added"),
+			RouteId:     proto.String("3"),
+			DirectionId: proto.Uint32(0),
+		},
+	})
+	for key, want := range map[string]interface{}{
+		"trip.in_schedule":  false,
+		"trip.route_id":     "3",
+		"trip.direction_id": uint32(0),
+	} {
+		if got, _ := event.GetValue(key); got != want {
+			t.Errorf("%s = %v, want %v", key, got, want)
+		}
+	}
+	if _, err := event.GetValue("trip.headsign"); err == nil {
+		t.Error("unexpected headsign for unscheduled trip")
+	}
+}
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/benwtrent/gtfsbeat/beater"
+
+	"github.com/elastic/beats/libbeat/cmd/instance"
+)
+
+//genReplayCmd the command publishing archived feed snapshots again
+func genReplayCmd() *cobra.Command {
+	options := beater.ReplayOptions{}
+	replayCmd := &cobra.Command{
+		Use:   "replay <path>",
+		Short: "Publish archived feed snapshots again",
+		Long: `Reads the feed snapshots archived at path, a file or a directory searched for
+.pb and .pb.gz files, and publishes them through the configured output as if
+they were polled again, with their original timestamps.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Path = args[0]
+			if options.Speed < 0 {
+				return errors.New("speed must not be negative")
+			}
+			return instance.Run(instance.Settings{Name: Name}, beater.NewReplayer(options))
+		},
+	}
+	replayCmd.Flags().StringVar(&options.Feed, "feed", "", "Name of the configured feed the snapshots were read from, the first feed by default")
+	replayCmd.Flags().Float64Var(&options.Speed, "speed", 0, "Replay N times faster than the snapshots were produced, 0 for as fast as possible")
+	return replayCmd
+}
+
+func init() {
+	RootCmd.AddCommand(genReplayCmd())
+}
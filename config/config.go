@@ -3,11 +3,39 @@
 
 package config
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
 
 type Config struct {
-	Period         time.Duration `config:"period"`
-	URL            string        `config:"url"`
+	Period           time.Duration `config:"period"`
+	URL              string        `config:"url"`
+	Path             string        `config:"path"`
+	Language         string        `config:"language"`
+	Timeout          time.Duration `config:"timeout"`
+	ConnectTimeout   time.Duration `config:"connect_timeout"`
+	Retry            RetryConfig   `config:"retry"`
+	HealthPeriod     time.Duration `config:"health_period"`
+	Archive          ArchiveConfig `config:"archive"`
+	OffRouteDistance float64       `config:"off_route_distance"`
+	Headway          HeadwayConfig `config:"headway"`
+	Vehicle          VehicleConfig `config:"vehicle"`
+	AuthConfig       `config:",inline"`
+	StaticConfig     `config:",inline"`
+	Static           *StaticConfig `config:"static"`
+	Feeds            []FeedOptions `config:"feeds"`
+}
+
+// StaticConfig locates the files of a static GTFS bundle. When a source is
+// given the files are read from it by their standard GTFS names, otherwise
+// from the individual paths.
+type StaticConfig struct {
+	Source         string        `config:"source"`
+	Refresh        time.Duration `config:"refresh"`
 	Agency         string        `config:"agency"`
 	Stops          string        `config:"stops"`
 	Routes         string        `config:"routes"`
@@ -17,26 +45,368 @@ type Config struct {
 	CalendarDates  string        `config:"calendar_dates"`
 	FareAttributes string        `config:"fare_attributes"`
 	FareRules      string        `config:"fare_rules"`
-	Shapes         string        `config:"Shapes"`
+	Shapes         string        `config:"shapes"`
 	Frequency      string        `config:"frequency"`
 	Transfers      string        `config:"transfers"`
 	FeedInfo       string        `config:"feed_info"`
 }
 
+// inherit fills the unset options of a static block from its parent. A block
+// that locates no bundle of its own, having neither a source nor stops, such
+// as one only setting refresh, reads the bundle of its parent.
+func (s StaticConfig) inherit(parent StaticConfig) StaticConfig {
+	refresh := s.Refresh
+	if s.Source == "" && s.Stops == "" {
+		s = parent
+	}
+	s.Refresh = refresh
+	if s.Refresh <= 0 {
+		s.Refresh = parent.Refresh
+	}
+	return s
+}
+
+// FeedConfig describes a single realtime feed and the static bundle it refers to.
+// The feed is read from a local file or directory when a path or a file:// url
+// is given, and requested over http otherwise.
+type FeedConfig struct {
+	Name             string        `config:"name"`
+	URL              string        `config:"url"`
+	Path             string        `config:"path"`
+	Period           time.Duration `config:"period"`
+	Timeout          time.Duration `config:"timeout"`
+	ConnectTimeout   time.Duration `config:"connect_timeout"`
+	Retry            RetryConfig   `config:"retry"`
+	HealthPeriod     time.Duration `config:"health_period"`
+	Archive          ArchiveConfig `config:"archive"`
+	OffRouteDistance float64       `config:"off_route_distance"`
+	Headway          HeadwayConfig `config:"headway"`
+	Vehicle          VehicleConfig `config:"vehicle"`
+	AuthConfig       `config:",inline"`
+	Language         string        `config:"language"`
+	Static           *StaticConfig `config:"static"`
+}
+
+// FeedOptions are the options of an entry of feeds. Options left unset, nil
+// for those where 0 is a valid setting, are inherited from the top level
+// configuration.
+type FeedOptions struct {
+	Name             string         `config:"name"`
+	URL              string         `config:"url"`
+	Path             string         `config:"path"`
+	Period           time.Duration  `config:"period"`
+	Timeout          *time.Duration `config:"timeout"`
+	ConnectTimeout   *time.Duration `config:"connect_timeout"`
+	Retry            RetryOptions   `config:"retry"`
+	HealthPeriod     *time.Duration `config:"health_period"`
+	Archive          ArchiveConfig  `config:"archive"`
+	OffRouteDistance *float64       `config:"off_route_distance"`
+	Headway          HeadwayOptions `config:"headway"`
+	Vehicle          VehicleOptions `config:"vehicle"`
+	AuthConfig       `config:",inline"`
+	Language         string        `config:"language"`
+	Static           *StaticConfig `config:"static"`
+}
+
+// RetryOptions are the retry options of an entry of feeds
+type RetryOptions struct {
+	MaxRetries       *int           `config:"max_retries"`
+	Backoff          *time.Duration `config:"backoff"`
+	MaxBackoff       *time.Duration `config:"max_backoff"`
+	CircuitThreshold *int           `config:"circuit_threshold"`
+	CircuitTimeout   *time.Duration `config:"circuit_timeout"`
+}
+
+// HeadwayOptions are the headway options of an entry of feeds
+type HeadwayOptions struct {
+	BunchingRatio *float64 `config:"bunching_ratio"`
+	GapRatio      *float64 `config:"gap_ratio"`
+}
+
+// VehicleOptions are the vehicle options of an entry of feeds
+type VehicleOptions struct {
+	StaleAfter *time.Duration `config:"stale_after"`
+	StuckAfter *time.Duration `config:"stuck_after"`
+}
+
+// RetryConfig controls how failed requests of a feed are retried within a
+// poll, and after how many failed polls the feed is no longer requested for
+// a while
+type RetryConfig struct {
+	MaxRetries       int           `config:"max_retries"`
+	Backoff          time.Duration `config:"backoff"`
+	MaxBackoff       time.Duration `config:"max_backoff"`
+	CircuitThreshold int           `config:"circuit_threshold"`
+	CircuitTimeout   time.Duration `config:"circuit_timeout"`
+}
+
+// ArchiveConfig keeps the raw body of every feed message requested over http,
+// compressed, under path/<feed>/YYYY/MM/DD/HH. Archives older than the
+// retention are removed, unless it is 0.
+type ArchiveConfig struct {
+	Path      string        `config:"path"`
+	Retention time.Duration `config:"retention"`
+}
+
+// HeadwayConfig flags consecutive vehicles of a route as bunching when their
+// headway is below bunching_ratio times the scheduled headway, and as a gap
+// when it is above gap_ratio times the scheduled headway
+type HeadwayConfig struct {
+	BunchingRatio float64 `config:"bunching_ratio"`
+	GapRatio      float64 `config:"gap_ratio"`
+}
+
+// VehicleConfig flags vehicle positions older than stale_after as stale, and
+// vehicles on a trip that have not moved for stuck_after as stuck
+type VehicleConfig struct {
+	StaleAfter time.Duration `config:"stale_after"`
+	StuckAfter time.Duration `config:"stuck_after"`
+}
+
+// AuthConfig authenticates the requests of a realtime feed. Secrets can be
+// referenced from the beats keystore as ${NAME}.
+type AuthConfig struct {
+	Headers     map[string]string `config:"headers"`
+	Params      map[string]string `config:"params"`
+	Username    string            `config:"username"`
+	Password    string            `config:"password"`
+	BearerToken string            `config:"bearer_token"`
+	OAuth2      *OAuth2Config     `config:"oauth2"`
+}
+
+// OAuth2Config requests access tokens with the OAuth2 client credentials grant
+type OAuth2Config struct {
+	TokenURL       string            `config:"token_url"`
+	ClientID       string            `config:"client_id"`
+	ClientSecret   string            `config:"client_secret"`
+	Scopes         []string          `config:"scopes"`
+	EndpointParams map[string]string `config:"endpoint_params"`
+}
+
+// IsEmpty whether no authentication is configured
+func (a AuthConfig) IsEmpty() bool {
+	return len(a.Headers) == 0 && len(a.Params) == 0 && a.Username == "" && a.BearerToken == "" && a.OAuth2 == nil
+}
+
+// Validate checks that at most one authentication scheme is complete
+func (a AuthConfig) Validate() error {
+	schemes := 0
+	if a.Username != "" {
+		schemes++
+	}
+	if a.BearerToken != "" {
+		schemes++
+	}
+	if a.OAuth2 != nil {
+		schemes++
+		if a.OAuth2.TokenURL == "" || a.OAuth2.ClientID == "" || a.OAuth2.ClientSecret == "" {
+			return errors.New("oauth2 requires a token_url, client_id and client_secret")
+		}
+	}
+	if schemes > 1 {
+		return errors.New("only one of username, bearer_token and oauth2 can be set")
+	}
+	if a.Password != "" && a.Username == "" {
+		return errors.New("password requires a username")
+	}
+	return nil
+}
+
+// DefaultFeedName is the name given to the feed configured by the top level url
+const DefaultFeedName = "default"
+
 var DefaultConfig = Config{
-	Period:         5 * time.Minute,
-	URL:            "http://gtfs.viainfo.net/gtfs-realtime/trapezerealtimefeed.pb",
-	Agency:         "./agency.txt",
-	Stops:          "./stops.txt",
-	Routes:         "./routes.txt",
-	Trips:          "./trips.txt",
-	StopTimes:      "./stop_times.txt",
-	Calendar:       "./calendar.txt",
-	CalendarDates:  "./calendar_dates.txt",
-	FareAttributes: "./fare_attributes.txt",
-	FareRules:      "./fare_rules.txt",
-	Shapes:         "./shapes.txt",
-	Frequency:      "./frequency.txt",
-	Transfers:      "./transfers.txt",
-	FeedInfo:       "./feed_info.txt",
+	Period:           5 * time.Minute,
+	URL:              "http://gtfs.viainfo.net/gtfs-realtime/trapezerealtimefeed.pb",
+	Timeout:          30 * time.Second,
+	ConnectTimeout:   10 * time.Second,
+	HealthPeriod:     time.Minute,
+	OffRouteDistance: 100,
+	Headway: HeadwayConfig{
+		BunchingRatio: 0.5,
+		GapRatio:      1.5,
+	},
+	Vehicle: VehicleConfig{
+		StaleAfter: 5 * time.Minute,
+		StuckAfter: 10 * time.Minute,
+	},
+	Retry: RetryConfig{
+		MaxRetries:       3,
+		Backoff:          time.Second,
+		MaxBackoff:       30 * time.Second,
+		CircuitThreshold: 5,
+		CircuitTimeout:   time.Minute,
+	},
+	StaticConfig: StaticConfig{
+		Agency:         "./agency.txt",
+		Stops:          "./stops.txt",
+		Routes:         "./routes.txt",
+		Trips:          "./trips.txt",
+		StopTimes:      "./stop_times.txt",
+		Calendar:       "./calendar.txt",
+		CalendarDates:  "./calendar_dates.txt",
+		FareAttributes: "./fare_attributes.txt",
+		FareRules:      "./fare_rules.txt",
+		Shapes:         "./shapes.txt",
+		Frequency:      "./frequencies.txt",
+		Transfers:      "./transfers.txt",
+		FeedInfo:       "./feed_info.txt",
+	},
+}
+
+// GetFeeds returns the configured feeds. When no feeds are listed the top level
+// url and static files make up a single feed. Unset feed options are inherited
+// from the top level configuration, its authentication only by feeds requested
+// from the host of the top level url.
+func (c *Config) GetFeeds() []FeedConfig {
+	static := &c.StaticConfig
+	if c.Static != nil {
+		inherited := c.Static.inherit(c.StaticConfig)
+		static = &inherited
+	}
+	if len(c.Feeds) == 0 {
+		return []FeedConfig{{
+			Name:             DefaultFeedName,
+			URL:              c.URL,
+			Path:             c.Path,
+			Period:           c.Period,
+			Timeout:          c.Timeout,
+			ConnectTimeout:   c.ConnectTimeout,
+			Retry:            c.Retry,
+			HealthPeriod:     c.HealthPeriod,
+			Archive:          c.Archive,
+			OffRouteDistance: c.OffRouteDistance,
+			Headway:          c.Headway,
+			Vehicle:          c.Vehicle,
+			AuthConfig:       c.AuthConfig,
+			Language:         c.Language,
+			Static:           static,
+		}}
+	}
+	feeds := make([]FeedConfig, len(c.Feeds))
+	for i, options := range c.Feeds {
+		feed := FeedConfig{
+			Name:             options.Name,
+			URL:              options.URL,
+			Path:             options.Path,
+			Period:           options.Period,
+			Timeout:          durationOr(options.Timeout, c.Timeout),
+			ConnectTimeout:   durationOr(options.ConnectTimeout, c.ConnectTimeout),
+			Retry:            options.Retry.inherit(c.Retry),
+			HealthPeriod:     durationOr(options.HealthPeriod, c.HealthPeriod),
+			Archive:          options.Archive,
+			OffRouteDistance: floatOr(options.OffRouteDistance, c.OffRouteDistance),
+			Headway:          options.Headway.inherit(c.Headway),
+			Vehicle:          options.Vehicle.inherit(c.Vehicle),
+			AuthConfig:       options.AuthConfig,
+			Language:         options.Language,
+			Static:           options.Static,
+		}
+		if feed.Period <= 0 {
+			feed.Period = c.Period
+		}
+		if feed.Archive.Path == "" {
+			feed.Archive = c.Archive
+		}
+		// Credentials are only sent to the host they were configured for
+		if feed.AuthConfig.IsEmpty() && sameHost(feed.URL, c.URL) {
+			feed.AuthConfig = c.AuthConfig
+		}
+		if feed.Language == "" {
+			feed.Language = c.Language
+		}
+		if feed.Static == nil {
+			feed.Static = static
+		} else {
+			inherited := feed.Static.inherit(*static)
+			feed.Static = &inherited
+		}
+		feeds[i] = feed
+	}
+	return feeds
+}
+
+func (r RetryOptions) inherit(parent RetryConfig) RetryConfig {
+	return RetryConfig{
+		MaxRetries:       intOr(r.MaxRetries, parent.MaxRetries),
+		Backoff:          durationOr(r.Backoff, parent.Backoff),
+		MaxBackoff:       durationOr(r.MaxBackoff, parent.MaxBackoff),
+		CircuitThreshold: intOr(r.CircuitThreshold, parent.CircuitThreshold),
+		CircuitTimeout:   durationOr(r.CircuitTimeout, parent.CircuitTimeout),
+	}
+}
+
+func (h HeadwayOptions) inherit(parent HeadwayConfig) HeadwayConfig {
+	return HeadwayConfig{
+		BunchingRatio: floatOr(h.BunchingRatio, parent.BunchingRatio),
+		GapRatio:      floatOr(h.GapRatio, parent.GapRatio),
+	}
+}
+
+func (v VehicleOptions) inherit(parent VehicleConfig) VehicleConfig {
+	return VehicleConfig{
+		StaleAfter: durationOr(v.StaleAfter, parent.StaleAfter),
+		StuckAfter: durationOr(v.StuckAfter, parent.StuckAfter),
+	}
+}
+
+func durationOr(value *time.Duration, parent time.Duration) time.Duration {
+	if value == nil {
+		return parent
+	}
+	return *value
+}
+
+func intOr(value *int, parent int) int {
+	if value == nil {
+		return parent
+	}
+	return *value
+}
+
+func floatOr(value *float64, parent float64) float64 {
+	if value == nil {
+		return parent
+	}
+	return *value
+}
+
+// sameHost whether both urls are requested from the same host
+func sameHost(a, b string) bool {
+	first, err := url.Parse(a)
+	if err != nil || first.Host == "" {
+		return false
+	}
+	second, err := url.Parse(b)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(first.Host, second.Host)
+}
+
+// Validate checks that every feed can be polled and is uniquely named
+func (c *Config) Validate() error {
+	names := map[string]bool{}
+	for _, feed := range c.GetFeeds() {
+		if feed.Name == "" {
+			return errors.New("every feed requires a name")
+		}
+		if names[feed.Name] {
+			return fmt.Errorf("duplicate feed name %s", feed.Name)
+		}
+		names[feed.Name] = true
+		if feed.URL == "" && feed.Path == "" {
+			return fmt.Errorf("feed %s requires a url or a path", feed.Name)
+		}
+		if feed.Period <= 0 {
+			return fmt.Errorf("feed %s requires a positive period", feed.Name)
+		}
+		if feed.Headway.BunchingRatio > 0 && feed.Headway.GapRatio > 0 && feed.Headway.BunchingRatio >= feed.Headway.GapRatio {
+			return fmt.Errorf("feed %s requires a headway bunching_ratio below its gap_ratio", feed.Name)
+		}
+		if err := feed.AuthConfig.Validate(); err != nil {
+			return fmt.Errorf("feed %s: %v", feed.Name, err)
+		}
+	}
+	return nil
 }
@@ -1,3 +1,162 @@
+//go:build !integration
 // +build !integration
 
 package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetFeedsDefault(t *testing.T) {
+	c := DefaultConfig
+	feeds := c.GetFeeds()
+	if len(feeds) != 1 {
+		t.Fatalf("expected a single feed, got %d", len(feeds))
+	}
+	if feeds[0].Name != DefaultFeedName || feeds[0].URL != c.URL || feeds[0].Period != c.Period {
+		t.Errorf("unexpected default feed %+v", feeds[0])
+	}
+	if feeds[0].Static.Stops != "./stops.txt" {
+		t.Errorf("unexpected stops file %s", feeds[0].Static.Stops)
+	}
+}
+
+func TestGetFeedsInheritsDefaults(t *testing.T) {
+	c := DefaultConfig
+	c.Language = "en"
+	c.Feeds = []FeedOptions{
+		{Name: "vehicles", URL: "http://localhost/vehicles.pb", Period: 10 * time.Second},
+		{Name: "alerts", URL: "http://localhost/alerts.pb", Static: &StaticConfig{Stops: "other/stops.txt"}},
+	}
+	feeds := c.GetFeeds()
+	if len(feeds) != 2 {
+		t.Fatalf("expected 2 feeds, got %d", len(feeds))
+	}
+	if feeds[0].Period != 10*time.Second || feeds[0].Language != "en" || feeds[0].Static.Stops != "./stops.txt" {
+		t.Errorf("unexpected feed %+v", feeds[0])
+	}
+	if feeds[1].Period != c.Period || feeds[1].Static.Stops != "other/stops.txt" {
+		t.Errorf("unexpected feed %+v", feeds[1])
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestValidateFeeds(t *testing.T) {
+	c := DefaultConfig
+	c.Feeds = []FeedOptions{
+		{Name: "vehicles", URL: "http://localhost/vehicles.pb"},
+		{Name: "vehicles", URL: "http://localhost/other.pb"},
+	}
+	if err := c.Validate(); err == nil {
+		t.Error("expected duplicate feed names to fail")
+	}
+	c.Feeds = []FeedOptions{{Name: "vehicles"}}
+	if err := c.Validate(); err == nil {
+		t.Error("expected missing url to fail")
+	}
+	c.Feeds = []FeedOptions{{URL: "http://localhost/vehicles.pb"}}
+	if err := c.Validate(); err == nil {
+		t.Error("expected missing name to fail")
+	}
+	ratio := 2.0
+	c.Feeds = []FeedOptions{{Name: "vehicles", URL: "http://localhost/vehicles.pb", Headway: HeadwayOptions{BunchingRatio: &ratio}}}
+	if err := c.Validate(); err == nil {
+		t.Error("expected a bunching ratio above the gap ratio to fail")
+	}
+}
+
+func TestGetFeedsInheritsAuth(t *testing.T) {
+	c := DefaultConfig
+	c.URL = "http://localhost/trips.pb"
+	c.AuthConfig = AuthConfig{Headers: map[string]string{"x-api-key": "secret"}}
+	c.Feeds = []FeedOptions{
+		{Name: "vehicles", URL: "http://localhost/vehicles.pb"},
+		{Name: "alerts", URL: "http://localhost/alerts.pb", AuthConfig: AuthConfig{BearerToken: "token"}},
+		{Name: "other", URL: "http://example.com/vehicles.pb"},
+		{Name: "file", Path: "vehicles.pb"},
+	}
+	feeds := c.GetFeeds()
+	if feeds[0].Headers["x-api-key"] != "secret" {
+		t.Errorf("expected the api key to be inherited, got %+v", feeds[0].AuthConfig)
+	}
+	if len(feeds[1].Headers) != 0 || feeds[1].BearerToken != "token" {
+		t.Errorf("expected the feed authentication to be kept, got %+v", feeds[1].AuthConfig)
+	}
+	for _, feed := range feeds[2:] {
+		if !feed.AuthConfig.IsEmpty() {
+			t.Errorf("expected feed %s on another host not to inherit the api key, got %+v", feed.Name, feed.AuthConfig)
+		}
+	}
+}
+
+func TestGetFeedsKeepsExplicitZero(t *testing.T) {
+	c := DefaultConfig
+	noRetries, noDistance, noTimeout := 0, 0.0, time.Duration(0)
+	c.Feeds = []FeedOptions{
+		{Name: "vehicles", URL: "http://localhost/vehicles.pb"},
+		{
+			Name:             "alerts",
+			URL:              "http://localhost/alerts.pb",
+			Timeout:          &noTimeout,
+			HealthPeriod:     &noTimeout,
+			Retry:            RetryOptions{MaxRetries: &noRetries},
+			OffRouteDistance: &noDistance,
+			Headway:          HeadwayOptions{GapRatio: &noDistance},
+		},
+	}
+	feeds := c.GetFeeds()
+	if feeds[0].Retry != c.Retry || feeds[0].OffRouteDistance != c.OffRouteDistance || feeds[0].Timeout != c.Timeout {
+		t.Errorf("expected unset options to be inherited, got %+v", feeds[0])
+	}
+	feed := feeds[1]
+	if feed.Retry.MaxRetries != 0 || feed.OffRouteDistance != 0 || feed.Timeout != 0 || feed.HealthPeriod != 0 || feed.Headway.GapRatio != 0 {
+		t.Errorf("expected explicit zeros to be kept, got %+v", feed)
+	}
+	if feed.Retry.Backoff != c.Retry.Backoff || feed.Headway.BunchingRatio != c.Headway.BunchingRatio {
+		t.Errorf("expected the unset options of a block to be inherited, got %+v", feed)
+	}
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestValidateAuth(t *testing.T) {
+	c := DefaultConfig
+	c.Feeds = []FeedOptions{{Name: "vehicles", URL: "http://localhost/vehicles.pb", AuthConfig: AuthConfig{Username: "user", BearerToken: "token"}}}
+	if err := c.Validate(); err == nil {
+		t.Error("expected several authentication schemes to fail")
+	}
+	c.Feeds[0].AuthConfig = AuthConfig{OAuth2: &OAuth2Config{TokenURL: "http://localhost/token"}}
+	if err := c.Validate(); err == nil {
+		t.Error("expected incomplete oauth2 credentials to fail")
+	}
+	c.Feeds[0].AuthConfig.OAuth2.ClientID = "id"
+	c.Feeds[0].AuthConfig.OAuth2.ClientSecret = "secret"
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestGetFeedsStaticBlockWithoutBundle(t *testing.T) {
+	c := DefaultConfig
+	c.Static = &StaticConfig{Refresh: 24 * time.Hour}
+	feeds := c.GetFeeds()
+	if static := feeds[0].Static; static.Refresh != 24*time.Hour || static.Stops != "./stops.txt" || static.Trips != "./trips.txt" {
+		t.Errorf("expected the default files refreshed daily, got %+v", static)
+	}
+
+	c.Feeds = []FeedOptions{
+		{Name: "vehicles", URL: "http://localhost/vehicles.pb"},
+		{Name: "alerts", URL: "http://localhost/alerts.pb", Static: &StaticConfig{Source: "alerts.zip"}},
+	}
+	feeds = c.GetFeeds()
+	if static := feeds[0].Static; static.Refresh != 24*time.Hour || static.Stops != "./stops.txt" {
+		t.Errorf("unexpected static files %+v", static)
+	}
+	if static := feeds[1].Static; static.Source != "alerts.zip" || static.Stops != "" || static.Refresh != 24*time.Hour {
+		t.Errorf("expected the feed bundle refreshed daily, got %+v", static)
+	}
+}
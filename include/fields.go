@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvf9zGzeSOPq7/wo8p+rJvqMoyZYdR1d797S2k+it7egs+3J7lysRnGmSiGaACYARzbx6//un0A1gMF8oUbLoteu0P2ys4QzQaDS6G/31O/br8ft3J+9++r/YK8WksgxyYZldCMNmogCWCw2ZLVYjJixbcsPmIEFzCzmbrphdAHv98oxVWv0OmR09+I5NuYGcKYnPL0EboSQ7GO+P98cPvmOnBXAD7FIYYdnC2soc7e3NhV3U03Gmyj0ouLEi24PMMKuYqedzMJZlCy7ngI/csDMBRW7GDx7ssgtYHTHIzAPGrLAFHLkXHjCWg8m0qKxQEh+xH/03zH999ICxXSZ5CUds5/+xogRjeVntPGCMsQIuoThimdKAf2v4oxYa8iNmdU2P7KqCI5ZzS3+25tt5xS3suTHZcgES0QSXIC1TWsyFdOgbP8DvGPvgcC0MvpTH7+CT1TxzaJ5pVTYjjNzEIuNFsWIaKg0GpBVyjhP5EZvpBjfMqFpnEOc/mSUf0G9swQ2TKkBbsIieEZHGJS9qQKAjMJWq6sJN44f1k82ENha/74ClIQNx2UBViQoKIRu43nuc036xmdKMFwWNYMa0T/CJl5Xb9J0n+wfPd/ef7T55+mH/xdH+s6Onh+MXz57+106yzQWfQmEGN5h2U00dFeMD+uc5Pb+A1VLpfGCjX9bGqtK9sEc4qbjQJq7hJZdsCqx2R8IqxvOclWA5E3KmdMndIO65XxM7W6i6yPEYZkpaLiSTYNzWEThIvu5/x0VBe2AY18CMVQ5R3ARIIwCvA4ImucouQE8YlzmbXLwwE4+ODib9d7yqCpFxWuVMqd0p1/4nkJdH7sDndeZ+TvBbgjF8Dlcg2MInO4DFH5VmhZp7PCA5+LH85nts0E/uTf/ziKnKilL8GcnOkcmlgKU7EkIyjm+7B6AjUtx0xuo6s7VDW6Hmhi2FXajaMi4bqm/BMGLKLkB77sEy2tlMyYxbkAnhW+WAKBlni7rkclcDz/m0AGbqsuR6xVRy4NJTWNaFFVUR124YfBLGnfgFrJoJy6mQkDMhrWJKxre7J+JnKArFflW6yJMtsnx+1QFICV3MpdJwzqfqEo7Ywf6Tw/7OvRHGuvX470ykdMvnDHi2CKtsH9b/ftjQz8MRewjy8snD/0mPKp+DJErxXP04PphrVVdH7MkAHX1YAH0Zd8mfIs9bOeNTt8nEBWd26Q6P45/WybdZoH25cjjn7hAWhTt2I5aDpX8ozdTUgL5020PkqhyZLZTbKaWZ5RdgWAnc1BpK94IfNr7WPZyGCZkVdQ7sr8AdG8C1GlbyFeOFUUzX0n3t59VmjAINFzr+J79UP6RZOB45hYYdI2U7+LkoTKA9QpKupXTnRBGCHGzJ+sJ5Xy5Ap8x7wasKHAW6xeJJjUtFxu4QID01zpSyUlm352GxR+yEpsucIqBmtGg8t+4gjhr4xo4UmFdEpsDtODm/x6dvUSXxgrO9IL/jvKr23FJEBmPW0EbKfHMFAXXIdVHPYGJG1CIMc+KV2YVW9XzB/qihduOblbFQGlaIC2B/47MLPmLvIRdEH5VWGRgj5Dxsin/d1NnCMek3am4sNwtG62BniG6PMjqISOSEwqitNKcDqgWUoHlxLgLX8ecZPlmQecOLeqd67bnunqXXYQ4mcndEZgI0kY8wHpGPxAw5ELIp8zjSddBpnCTTJWoHQYHjmVbGCX9juXbnaVpbNqHtFvkE98PthEdGwjRe8MPZs/39WQsR3eVHdvZZS/8oxR9Ovbn5uqO4dSRKhI3fLVGuT4EhGYt87fLy1vLc/29jgV5rwfOVcoTeDhrG6S1ihySC5uISUG3h0n9Gb/ufF1BUs7pwh8gdar/COLBdKvajP9BMSGO5zLwa0+FHxk2MTMkRiRenrBGnUHHNvQril2+YBMjp/rFciGzRnyqe7EyVbjKnXifrPpk5xTdwHlwqsaTwSM0sSFbAzDIoK7vqb+VMqdYuuo3axi5+WFVXbF/gdm4CZixfGcaLpftPxK1TBc0ikCZtq9fG6VsnzccNamTk2RGrzbtE4n6KKTSvoAgTs9bGNzvWJYDW5pc8W7grQR/F6TgBz/6yuQVU/4e/xraR3YHpubvj7ursSaLGZIXo6DEvmydXKDLH/ktHcDnMUOHjtHNCCiu4VciUOJNgl0pfOE1HAipU7tQF2EhB0TDnOkfB5eSSkmaUvE9Cayropi+U03xnhVq6G5rT6Vpq84eXp35UOhUNmD3Y3AP3egIZchEDMqor7p2zv79jFc8uwD4yj8c4C2nalVZWZaroTUU3WidWWpMGPUvjdR3cpShoAgFLVnNpOAIzZmeqhCiba0M6jgVdsofhmq70w0ar1zAD3QJFdhZoSM3wP3sdlHZ2ClEHQx00QQCBwBxYch62uZkihZ+0aU9EYQJ3cmpTO4T4URvlT0gH3u+1pA1AXZC0u2BEYQOjNQiWyvbGdFydNmwXD1m4vsZLL423FyaKZgpk1iQn3E3YQMmlFRlq6fDJepECn0hZGBEHfxBZexAsVrFL4dYr/oRGs3crBY3avhG25n4/TmZspWod55jxogjUJ2SQaxbmSq9G7tXAEY0VRcFAOt3WEy7ZRhzXzMFYRx8Opw5hM1EUUeniVaVVpQW3UKxuoNXxPNdgzLYUOiR3UuE9cfkJPfONfKacinmtalOsiJzxm8ixlw4tRpWANiFWuBsgl+zkdMQ4y1XpNkBpxlktxSdmlKOTMWN/bzDrZQQaLRq1YAFM82WAKRD+ZOwfTAhlbREn3Q2gkWB5TUYLuoJOxqKaOFAmYwJr4q5xFcjc6xikICjZAIH3Cb9jYVemKwvmGplSqKjr09Wi/VlrH/7qfqBrRbTs+f1w92bHD+g60JUvBy8OW4DRorYg7fz5pfHHrTnnoMaZsKvzLWmmL4Vd4VS91b9V0mrgRR8cJa2QIO22YHqXaMlxsh5875S2C3ZcghYZHwCyllavzoVR55nKt4I6moKdnP3C3BQ9CF8erwVrW7vpQRrc0Jdc8ryPqUJlqU6/Dpw5qPNKiciX2lYpJefC1jnx6oJb/KMHwc7/xx4WSj48YrvfPx0/Pzh88XR/xB4W3D48YofPxs/2n/1w8IL9/zs9IPv4ujs2/dGA3g28OPmJ1L2AnhHzyjdJYDVjc81lXXAt7CplqiuWOeaOOkfCPF8GnhmvNkThQpM0zUBa0F7zmhVKaSbrcgp6hKr8QjR6jYmDEngFqxYrI9w/gmktC8faJCC8UzZxH6DhUEjGa6tKZOFzUGG1/QvAVBmr5G6e9fZGw1wouc2T9h5nuOqg7f77y3VwbemoeZgGT9q/1zCFNqJEdQ0M8YU2cZ6cRgEdOCIKi5SyyAqgJDjZG23aJ6eXh+7Byenl80bx6MjakmdbwM3b45froE4nJ5X2BqK+NckpfX0rwf6kDYfS9rZAKG2vWmJtQI+h5KLYEvdyzIvhBAHjAwDM6qIYOAd3CsSOYW4anBZZFr/kouDTon88jospaMteC2kseIWqBS9q7eOtWVr71saZt6zjxNEggrfEvarg1umYA3glOLeI2FQTosn6QCy4WWxNNBKm3DzMzePOVaa0BncvbZn1Z3QDcS86mSKVXKVOQlLTE6b10YA3WU5wFSKnmwP+4VY3ia6kTMkZ7RUvWnM6XSPjsrkxs+D67XA5P8MWON0vHaZbd0krMkCEoQ/VlqTT2cIxJlIz0M0jZB+Q5EhyPJItO5qqacpoRgsP1lvRKOKDEXnkgQnjUAxNQzPNoxu4cXDRbZisw+FShzZittahNWNvwWqRkaHZpIZsLtnrl0/IjO0oZAY2W4BBLSsZnQlrvA+xAdJRV9v13fJhChMNpG0Q/Li6lt45qaFUNppTmaqtETkkM3UhI5g4896zsKCw6bL51GuIbS89DdoMhG5CP3kQhG5YYRpQPcJuYi/J8P6yPc6886FBEM2F7lE951L8SYde5NHl7U/ZiuViNgOd2kxQDxbo6GWcjueuBcmlZSAvhVaybCtRDW0d/3oWJxf5iP2k1LwAon/2y/uf2ElOTmk0mfYOfF9zfv78+ffff//ixYsffvihjU6SkKJw9/s/G7PIXWP1OJmHuXkcVsgWgzSNR6U5RD3mUJtd4MbuHnRUWu9J2B45nAQP0smrwL0Q1nAIu4CK3YMnTw+fPf/+xQ/7fJrlMNsfhniLIjvCnPr6+lAnCjg+7Lus7gyit4EPJN6rK9Fon4xLyEVdtrVkrS5FHoMUtqnqEAcIE47D4UwDsPjSjBj/s9YwYvOsGsWDrDTLxVxYXqgMuOxLuqVpLYtuiVtalL8k3vK4peKYGL3HfhDJrYdXOLfii20Hhvcs9OLjkpCdCjIxE+GOGKEg87z3QXkrvZqlgyTBlmAgzLuAokoUSJRXFL4ahzZeEsqVQ5AVJdxAQG1Fx/NKcLN4kbfPsCj5fKs8JT0bOFk0jRJAS27YtBaFdeJ8ADTL51uCrKEsDxeftwFIIkCvnj2JBL0iFrTLbHFSH1bZmneLu9GsuTH+RG5CJLstdkKjs5JLPnfaG/KTSAc9TkIRqAkbSbxoKSN51Xl8BStJXr3a3Urac/I2WlPJ5LPXjsQcGDPxsF7nWyXu432rX6Pvr+W63MgB2KixFLx9Rw7AOCw6Av93OwDTTQnGQh+l3zlEX8wLmB6De1fgvSvwbkC6dwVujrN7V+C9K/BbcgUmQuxb8we2QGdbdgreQNhvxTO4drH37sF79+C9e5Dduwe/Nfcg5X93MsCvMhy8Bct3090JpkWfYU5TbnJxvy7pYCBz/PPSspKsetS9fESvwsUYZtWYTSAzY//ShJJ4AhgNhaPHzhFlWRtLqUx4GIpePDdjv7qb9h816BVGqFMOVyQjIXORgWG7u/5GXfJVAAiT+AsxX9hiyDGWrAa/93UHHGiFE5xCWphrHzfO898dqEFkZgsoeQf/rJVca/rKIhYiSClHa9WyYr+OD67OM22syBkmJfkQdxoQzxGXK3YhZGOx+EgpBiWlRdF7aLmmjEqHvALIDevQHLJLkUdl3IBpUjHDsnDvhTVQzBrvK5c0+g3MT1tSjxGZOHi4IpCZEDyAbUV0i9byAek5AEGav74ejJjDPrjYkI2d0thlJwfo9eWGucy0v0NekpDOMOwoKVRQAsmhokXWopVIkseYHt9OMnLkE3iKIyi3ZUn6MFr+FrSPvMkGDkz6TZPGj4wlpDZjbo0owV1Wg/fJPXUDxTGajGg1SxbhxwtD8ZBhyzCJNARa+PCJJiWKdHc2Bcp88iq4H5MHU61VjKcq8YiMlwN5VVOwSwA3U8ifkLmPkYh+SJrMpyRRjnRWKCfk2XHYievRTZclP2SpNLgbN5qTChyR8lXwzzTRHAEaRnTymh+2SdVuYT2llgblJZRKr5hjcpgP44fLE8Q3BHdZFxI0efhFkwvvXzZOCYKcMuFvEuyxgSno1kEeNDrLeEUlIXwWZNsx4JNio7HDZ581B1AklV7G7ARdkrh7jXax4JJN6IWQdTRpMizjRrizPkGE7PI8n4zYxJP8LpI84KOZKGA30+AIbUKpOqEuSxwxJmAHivMrE26eEi07fSHplK7dihvjkLlL2VhtceFB38Z2vKbD4GfoIj8KuYWYL3z62TAPRA6JAnTW25U4Ju4OZrt1NocIYjIKe2pAGp8G1hiqeAQzwtWMHLQjHjIDf+XaHW6sfzCrMeYsqj5q5lShEVsCqwqOZgEfb8B4HLLwxTZ4lkFlMQfahyCQTAuq04hVVGWpNkBeqYzXw7Yz3Gn03zWsIW4yUdY1exwLIHX30RM5DdKLYhuujuR4EhYMimvWwJFmQ6o55aquKKevVzLIEwkpkO6oCsfWM297aYo8xcy/5FGzrR7WOGbkqAM1mWKtmC6rOJGsVMYmuYhoQHVEtFRNPSVD7rQpDGjJdKTDn1njpcraVYUyXmTokvTWnYKvoqxCPHlJ5wtBoQrvhU4TqNISHbgt+GmopqKNDVIXciY6Kf8BklJJ0STismSInR3UZMOOuT9DCJhV7AKgYnVFxIofpdWo2ljFFHSEtI1HxzJJzct4MUp3tvEPDty2c265gevMarfiZKk9xE/TydDPlHRHmez5E//OhD1ynN2AZXteHBuwjx09B8s4VZZwygMz9bQBH68/pcrrAgyyutaxS/kkaQZuB2vtaK1YhSJSQjaTphd+IpHmJ5rGbaqHFl/usxhjuW3HOOW13sSvM+BT7XwpZFXb8/Cj5FIZyFSTXd6JFfAftwSCW27yYbsQBJ1plLi4ePobnNangV1ItZRpObSGzuzwuQ2HEmeXdPum0ZPAonhrkJtYFNex3wbUHuftMl0c1O1jfO5E1mXqPHJ8ueBO+lBpoE7E0RaNej9zs2CPKtALXhksEISFc2ZCzkFXWkj72O2n5kvP9a1yG4DC0aq4gBxKJY3Vbvl440G7grCrAZN7CNkc+tfxX1+++mKX1pNXbjUxniVRSDswD9aOuRAbEdCtVWY3/nApMy+F5+ISI567ytnSK1HdGL2EJAPNNuIplGfzl7nEWneFrtfRp/HppBlz4lgTOE2aF1yXk69TRUMg22YK5Lzblliev5N/98qSOVQqKL0Htd5MRutKMKVjLaz+wsuV+aMd4xGUrW0s/T1fomUnFv1TM/RZ60hNH72ScwUvWaOGSuXkTA6fgHh+rrLzJHg4F8ZRSk4SG10EqBAC19kC8oZgp7VlIpZh0k4Uw2XQRifnpC1N+pg8g4od/MD2Xxw9eX50sE8hvy9f/3i0/39/d/Dk8F/OIKvdAugvZhdOaadbgaZnB2P/6sG+/0dzMpUumakzpxrO6oIUiaqCPHxA/zU6+8vBPpaBPWC5sX95Mj4YPxk/MZX9y8GTp21Hp6ptprYXV+HYl59iHQdrFUVtbvzuGpKRlag5zKYtY1sjJ6WOQtmZxtpCL3ru5FHoC3TOuChqDYM8KY64EW/anCfFcTfnTQRza++0MBfnJjmU647prFB80JD6XpgLhiNQNT2hHHG21bZHMJ6PmfGEy4wqEETzuDGmfDTgrz/oGsULiL+skb62AN2Nl42wn0ulyw3ob+0idt6h5UX8CTkOe82CRtE45nTqWVzEvtvLg/39gcpsJReSomW8b3KlatyzksIpuUQ7oq8uhNddboyYS5MAZNo3QDfEklPGsgFHPbJZBmHNe394UYTaSR3F1cAlJKFHN41UOPOfd+xsce/C8B1Z/+uCoqAalS9co5svPNmXwCUy0UvQyXU7qucOh+hvcQx5pzHp1FXQNxLrGV57+QUwtIv6qQSEJEJphLFoKya0Bdda5yDtfN/BobsVfLb6T3eLay8A3qSYXgFaTMtdBRrTzJo7gLvBbDFpbCeRqM09Kyly2lrSzo5pTANpjU/mZbH3SXiY20pqoYHnK89hcpjxurDsbGWcrG/sDQmjOSHrBkLKC8rEWwqT2i2OG94bJ6UpkVCO0JQolUST/skrP/nD17VWFewdl8aCznn58HFyXKdTDZfkZQivn314+BjdF5L9/PNRWTbELXgR3trdf3a0v//wcefYbqtK4XsgckFp45XqmlxkcS2+Kjy/VJhPGXMJmsrfGKvh1NBxWiV4Jrwe7B1rP4a/ryyth3XtO04YZsD27yPo3zJs6rhC2xzq/UTuV3SdB+8G2kKQLTZl89x0vn530N24MSoTTXle1MhCXb1WsTczcox5z5tZAt8g7wxuqNNElAFfkZss/DjlSdBL2Vsyyzm0/vePJ2//J1TvNo2TyWfkYgE+9EKTYhO0iH4uBZ/NgEyh7vXOegLVJGXvveXoJj7pDVNX1vHANzwUnkcQS7Cc4lnRn9FhXzm45W+Jeb3CwddkqVH6dNHRRHDufmDJ3fFT3OU4S1e9iIkahVoy4GblQLSAJDRdEULjxwNhFpWX7THqdWvhcadaYFF1CoZzrPOnk1eP1yO2obltw5Jm3PbhELIXcnGHSb8qh3Z3iABE8GelfIq1bQtbS/x1QCX4cKCozPKiUyCypxwdHjxvw3i3jMEbj1DDKVUuZqLLHNRSbi3RmKSDm2AHrSO6n8VXcbst8+opt4ug1PZp1Ig/N8HzOk0el+bGcDuN6VDsUbSJKHd34XkedLeJGwuD1dCvPXncUS+5noM93yIqPuAMiGzUOMyqLIS86EQobzExHtGFdlH0/4xYLjQqGR6SDkbqrbHUDz7uErnpR+SmurlqJ6FUj846rJYIOY19moNKFbSf/J9X6Gc/gUoj6zKu3SWtqXvCG+tvyAlJS7xwmepI7SY7SRpJS9HzSlkOWkRzmoVsgWb4pmy/g+zkNAl0IY+i3jV1VRUiuhY3Um6+nsy5rz5r7ivMmPvKsuW++ky5+yy5rzNL7mvMkPsKsuP6l4Ugv+KD9RLsQ0zNSQJ3S/BW1SZSHN/xEeDY/AAKuOTxcHqtLPH43qbkyFeVhvSlc49ifIIyrfjrn8PfV5qJQmGclpnIV8ZnmSqr2lKsr6/iFLs6vTyj4NbQmmnYYJl2ZWrMKtSDqSnQ0470D4HSqBaimjIY4ZvG9rq1Il5jMK8fccF1vuQaRuxSaFvzIhRgMiP2Cit1JFVw0AjF/lZPQUuw2KInhxvVt9DZQljIEv/VnWY2VSGyLTRTSObrnfNPL56fP2+XUbivZnBfzeDmIN1XM9gcZ/d62n01g+1XM3Dyc0uQ7Pzsx06rFqYhIzZpdxd8rkvvlmaTANnE6Q6lO78abK2pRGuvCOLOlVrdnba5Iz0nLax0bCIeQ/iS79lCGcMjdJF7b3rUX52KK+QcgxF89PiVxU1JU/bxx+QSdJidYIs8xFQXC7erVIEakKiGKw5sp8LEz34rh+fcFn2+u5I20Zjmk9SRKhOKTCjxIxbtosAOzyQxqOuPmhdoGo9j+lJfVEKBcuYcAN4616QaYQo37rVxkkSzHDKRYzar012RjBrGrtz7nY1XZjzjpShWWxJNv5wxGp89CrY+DfmC2xHLYSq4HLGZBpiafMSWQuZq2bj/m+p2+GYP7rrYVjGNns7ri1mglh98PiFVPKThDqugPHM4eKt+55fQXcGFU/m/2Bpotgg23rk0XzJj9VBx0sPx4Xh/9+Dgya5P4upCv0WFZg3+Q6Rygv11CP/PLrTh2vylIA7zebp3upEyI1ZPa2nrq2id66Xo0fpgKYTtAb8pjRzsjw8OxwctaLcV7BJacnbY749K+4rdoYqw7wvrPQ+t+uhuCGwsPImVjydY4P2yHCUKMAZZJ7puvKyP0rarSW3w1OPRyOo44pDMHihMcl8eqE1d9+WB7ssD3ZcH+rrLAy2sbVnxf/7w4RT/vknvEPdRDIcdh2IubFLrYhICU4ECp5PGlgikLgK8vjHt5vb88MFU5avxQCXa6wIyrq1Ge9aKz2iDyXDWLnpfvPh+PYg+mGZLZ/iDv47QZlwJ5c9QFIotlS7yYWi3gMsPyvKiE/HSwegjBywe9gVwpwf0lauDw6fDCC7BLtTWcvpaKKWpOtnKROSUBYC1XaaQpgdYxQq1BI0J2o6FhoJRY3YGPidWZXUZ4rzi2MbXV3l4EsLqnZb3+uXZw755bA52xCos9FLVdhBN2KZZby1g670fvsmeSTHX203He8zR3t60UPOxfzrOVLnXgd1UShr44uecpt30oKdAftmTfhWc6496gPdLn3UP7e0OuwfaWG5rM2DqvVEMXht9NOawcfdwv+0R2+5tDuFadz0+GKfNRkIdKC+83/g/r5XdZF7irfI7CjM20yScTYQwLn4b18VfQlKTgyo6PHwFr15OIhXxb6U0L7mWkxGbYDEz9w8xkP4JWreWs8002pCc1krZcosJabW8W5IAT3nyRqL+zqh2UiEsedotq7F0S9RQK65bdQpPyMSpeVMmcOKHDToaUUVqDMWW86Gwixsxzb8Le+FHSdM+O1mffrGj3oJCWm8cc8EvIaYZGbepFHachTqHFE1IRgCQmaJ+BZpJWLJCSDDY0O0yuZC4q0wBXGKOWhvkz81KZkb5pOOdHRT5TqynduBpMHahYvDZycnoaUOfxNuVP/vRcE6JMSk3eJc8uqaYXkiraYd0kOmkLGvp8U8RwOoSdOAgTfwIo11I0nN8SIZJGwyFN24VABJG79Tg6CYMhQI+NwnBqKg5xhaTSo7pljYXlyApGDed1XO4SiurMlW0SwhxPRVWc91Y+ZlPV/WpY1gq0NChKEWmVUhZGiEF8sIonGxFJ7952VysKmgsZyL7Y8RmPIOpUhcjZpfCWnJQCMOWaaUgx2qa8k1N8U12CTJPqhxhdDQ1NIyRxE7E5jFyOJZBoFOwlzsd++SUwqXNCAt7mxFLxlwKHTIEv0ItnIt2M7a7bpGyQ9oVaVVWc2lQ58YdmSp3boQGX1etlbM/8RWj8EufSp+WOw/PQ/meEZuEw+p/Itklmp0wddlHwNPnL1oI8BzErs6314zymKxWWIITk8eQaSe15E9OqQKkpyZu2BKKwjO5uJ5w/JrAhDb/G8cEc86sUsUun0tlrMic9ihzrlvNLuOws0It0814A1xLSkXnNt6C5sIu6inefxyBYMmzvYi8XZHvOl1toGzv0eKXfzbvDn/+57c/PXv7970XixP9n6d/ZIf/9e9/7v+ltRWRNLag3jx8FQYPelpg11bz2Uxk49/ke3DroaJKjTg9+k2y3yJyfmP/xIScqlrmv0nG/omp2iZ/CWlBS17QX46Cmr9qiYT7m/xN/roAmY5Z8qpKCgf7Fq5OeO1SV7uyyQP19WNHUSAlik06ZuRcbpgdwzA0yS3+UsByTDCsmTigRmlWgRYlWNAESAvozWBqAGlB4P6LXgs/WTpynHT8sEtOHvctupkpveQ6h/z8c+IMkq4YMSXdH9fkJ68gV1p9GqhA9cOT8cH4YNwuiSK45OcUqbQlBnNy/O6YnQbu8A6nYo/CyV0ul2MHw1jp+R4JZqw5uxf4yS4B138w/rSwZZHky595PoLyKlQnCV8Zz394gZUqkIOhxvMO7I+FWlLRNPyXN87GcQs1D7e+2ltnh9bUQ3g7u3DbHhBSjqYrptChiUXAVZC+polWC3KpC+1PaKD7VcxEC+zPa1TiBa4f5FYi1387IHSbXwbEbvix0c+8AB4WvE/aRopANdu4yr75PtwuGpmJ4RMMPo1Roo1YgRT1O8+cJumQ5mRvo+F+fZpbdIVET3iAehsoPHMEz02k5YSJkdaOXlPe1HwA9jeaJz2Gsah/g+GCrxxzqvNqxGxWjZioLp/viqysRgxsNn789WHeZh3EbykE4YSEzi9nJ5hxXZAQXaahAoGs3zgsjh3uDgmDyS2pMpCNWCVKROjXh04HdGIa8EVpWq0cfkmfXZXqIePn/bIgFWSCF4GCRzEPlkLeeldqqiMRC+LmYCGzozA+fkSFRK4fcbct37xylRRhbSe3xmAQzrLaWFXGDA8aFLuAo2PbL7VT3kTJmZjXTYsQq5iu5eYIYEbNrJsuqXDWzjiZCQ1LXhRm5DRcXWP0DmFIKLlXaVwiDhXiD4MOmWiJBqRROtatWsK0BUUyCcZ7F8oYNjS0Q+Tx6VuPDZN2Og3UkBpwOFVpXmO/8QyKBqeIEbkapfXfaJ0mkoIJZV2IHEyjMF+B4lBMxY/pS6qwt962+kcNNQ3MXn94gzlKSiLVhLueL+Hcbi/iySlYmjSgaRBrV+WAdfs9PrAp6+uXZzcwOt3n1dzn1dwcpPu8ms1xdp9Xc59X803n1XTTaqL0bds/bmeU6XcpHR7+i3UabSmq9wkO9wkO9wkO9wkOd5/gYEALXmzXYBzu134yL++vq5d1d027Qg+BlK3GZitXlasH7fMa3cUwaE7BEN2MtKrAjIeiboKrQKfNBMLFE6NwcoP/qYxv3fVphf9QRQEYpkOXWPev5go6EBsRxmyhtOV9vkukxpXTDGl4+rgDwdU9T++ApBLG0oQtzbkUfzbKfjDzdJ9fEweSjhPu9yC1yBZEOHixX9dTrKy4DFJaaa+vtoiuE6mRBoY0PUMXUFRYbJtrzeU8tNGxvsht0ouHSwrSQY9BO0A/gtGs5yYlOf4BKSkpqF+sNExKH1E9aLh6i5QiCz5DFnwNOX1AO2unCcAa0lEd7r559OE3qRl+42rhN6wTfkMK4TesDX71qmDiIY0tOjyXO00ebdzkei1zi914hyVdxmUj7Zp0O29zbvekw8DG2NxX5HsJLfugklZcLTLg0Bl1XGHa3cyCZMbylQmljkPXXeqSzWNXLFQQK0GOGkxKLNSUF0nR+QBuY1DarNTVfJNkg9vFgGnNVz5cApHE9Rwdaamd7C32f/T6BC2v0spCZtF5Iqy4bOU79vRO/+cuMzEbc5ftFvGftYl3il0Wmvq0oyjgE2Q1NjzYEiqOp9jzBShc1+9gwEoze++E7NVG702F3Atr+xIlKv2J81IobpS7WmBHCZbxogDMDp9rXsZcRyNKUfCBDr1d4KtrE0LXRX6cxtPWKTrdG/JGeSdh2IpjdZfu6J/b3+RD6FSa7rrvY9I32z/ZP3i+u/9s98nTD/svjvafHT09HL949vS/Og0wFhp4vlmm9rplf8Ax2MmrvtB+ctgO6EJmvG2Cw0k6YSgOXfh8RMkHRIHovvThGlVKruwllxRdPW2aWtqjOGRSbIBxNtVqadAkEHI2PBDhiC5hyio+h6TxqKLm7+3dWCp9IeT8nMKOer2m7zTRzM/F4lzBqhAlW5eJLFQJe7yglhFN6lbjr/ei9n3y6EpR2zS3AWobHuqFzngmCmGdzKzEpaLuvVrV2Hq+EpAl7aKwP0rYbLRb4Aum29jER6kbAOxZXnK5crpRhh57d+N8/fIs9FX6kILgh6bOdGhaoYtdOaIbKwb8BxGFHaLcFKFQlPL+IhSrplLSaetBvFNWimQTj8XxJK7kGPvkarDRDuMw1Fj2wYyStJ4psBrLDGFX+mjUGPkwzFFDBE3Hf+rnP2LhVS7zGLOUxoViGQ68tlcVNnAtCnZyGqS9VQ30opqMSOXhqIVIjzRfW4CCAE9OmdXiUvCiWI2YVKzk1mLeCUTuLSxOxjXkIzZdxViadKojPp6Os3E+ucntf5MmGMM+leMipqmdnBraYyWTvs3pBbsflnO2WVCOf28gXccTj6/OEGNEMiWlDyCaRfuYj3LQMOc6p/ARY6gbd/O+oa7iIoY4Oi2QIkwzpZOuwD8qzT68PI2deZBpRjAJtgyE+9sjSEiBpR7O/v7OR1c+MqFkflCXX54msIxxEqrYEmNiuzP5KrTFqoePsH3t0HRpQvNB5Ao+BobxzNbBl0oBdqBL9jCO95AKFs+itpdCITuAm1DjC3/22n9w+fYTnQIr8eVaM2JspjNFug7PkM5aE3DsJoWr8CM2ETpUbuP3WmbN9YJOuv96aLAGtU0pjmZId3ppG3fJjx5SSf2bL2n4vbCEdmcTug3x3HH5kksrshDz7pOl4BM1J/L8rLmouBvUrC7ca5fCLVf8CYnVUbIMNN7PmnylwKt0nGPGiyLwqtAAP+MW5kqviFn5PDVjRVEwkNjSDl9bk3HiEDYTTnX1w/Kq0qrSglsoVje5MxEn35Y6RDZ8anZHGxNFB+U6BgZTTsW8VrUpVkTN+E1UdbBVv4lKO3oMuGPjI8ZDOTwqHYNF9JSjkzFjf28w68sophVC6FS5O33MDiC6n4z9A5+62lbjpJMMTV5hXlOUGF33Jk7+YAmaMYE1GbEcnMjCTNJQXrpp14dyRnQ7Od51WtdfMZ8Li583GXHe2eIbOeP56Zs1XrTDvmlR10B2q1IzBA2N32kcdR/Jdh/Jdh/Jdh/Jdh/J9k1Hst0ykGynH0kW4sgayqLrZ8dNy05OLw/dg5PTy+eN4tGRtV8sAG0o+u3zksdOfdbYbQR72ya2QR7SWiAUFu5Yu8T74pX3xSvvi1ey++KV31rxSl9aBN9LLGjh0TXBTqEwSdceY9PflB7oJ+R0IQ/ckhuWqaLAhs/XBDTNhMx9kadAnZiXTWQZK3GFud2bIWZgc3MBVAsoQfNii+U2Xoc5UvakvAIYwH8kZijusQe4edyttSTypCUEWnYM45lWxjAN6K7y1WsmfkA8fbnCBku2r/q94IezZ/v7s7ZCs43jtNNnzaG6XS0lGVIJ4v6SvVWCTmARO4auWqjzaf4lvwDDhGWVMkZMyU8USScOjSSUpD4SzUroEdRQm4lgs9dunyrQAmSGviljajBkF3RjacjdAnw/r8Z8T470OG7oDC9yStxvghnwyhWInexmQs6x07HvEdbb0fzp9/AMpjPY5/A8O/zh+yf5FH6Y7R98f8gPnj/9fjp98eTw+9l1JQruvoFEoPAmltaf/4Fw2vQWFT/EAFtP+yiN0OcRqzsUamnwPrVUET3NdSqMhQ0lAqvQDfEFxcD9Hgun041PtvyUolUhwnekiKcNxVva+KSgYmcePLeNuTBWi2ntVh4qTtHe6hrdHlHiLJSxZph8yUofrNJ+sYyKsvildEIDfBY3plCrGXtdcGNF5n1ICZpxCT73N4hp0rdrY0G3bkXkv/grcGv6QwjjsJPDjNeFxZpAVXSDRnxZ7NGMHDmOKWZMKhbGiN0/BsoQpmvYTZNOk6gAuxVjjO8xg+N36PQfE65+o9OFHwbXpk8sJ/14QM62mKST6MglE4UhrGQNp8RBmqRgPHVt6NrEOOpQRxw0VhyYtDZ+qD5l+ntrO7YXaL7zHyFAtL0h0afS0nn6u9LwMKx2oC4Yd6eGgrfBUnvzjs5z2UzJI/n1S4uNn4zTygbkemmpf82TK7Q/eut6R1zw7SBUZAjYa1cebY+UeNyu8bWlniLvcPsqPULet3XvEfpKPEK0H95wlBYS6lmPvphbiEC6dwvdu4XuBqR7t9DmOLt3C927hb4ptxDVw/vW3EIearZtt9Dm0n07vqGBdd77hu59Q/e+IXbvG/rWfEO1Jo7lDQMf37/BP9dbBT6+fxPu8b4TJTN1hSU1KeHNTWQRnIpr3MuP79/4ann+zRjuvgA21cApdUItJRPSKmayBTjmQpelEeZn+e8VC2x+EwvA0G3u7g7NK3859+jWxShW63+4XC7H3ig1ztTDtlkWc2YyjoYCxGfJVxQk7YN4nUZApf0QrxRUXqyaPFneXhrzeTZo8sWGCAZGPrq+KSaN2ulcxbYm/hbvDQE9bbC9hBZeZ5rPy+11btpx0jaxrNW6YHxmfWmOyXeTBNFWVQ87xs7Jd5PQnMT3YiGF2wPd4RlbTDM/mZGodPSPJiFRuv30aTkYWF0baHZrldheqHxDXJeQ2CYQJfxkxJYLwPB+22rHoiFT0lhdo8HRUQ9FjgfjT9vwlKoxA93G2tt/dHj4dI/Mq//2x19a5tbvrGqXpR1uDnSXwoqa3eAafX8gJBET85Hiavuq9DtlfUS6kAPFQUdpLZg8nk4siho2c0TpNdyk28MzTHgr1Nxf8Nynwvh04t9rY5tQ/lAa1jG2tc11Yv5W/CwOy9HfueQmAjpqMd5Bz++tNtaNtubnjp5vTLKTd73np374wSaYDQx2WwrSKTb0ac2d8CCPoIfja24bN0t/TW4cvSkPD5/200MPn7bmxzSvbZ1Bx2dxAk+v0W6B8NIvVGBgcA2R5B36OnTVY+f/huwcPmEh4KSNQzoLpqqQMI09taRy3+JhTAzjVLUpgR0/taGiE8f5prWNb42SyWixFKoRR4zdlMrKNvAg6PTmxH/dccC1PMxsCnYJ0Eh0TKZaKtITOjKLFKRt7e0Zjr6e3JGRPOywVEqDnRwNil6Cdw1L6unKW77AppEGCR9JIWhpxOb6TMMPXt3uucqGC/ngqySCsD8wXPIol71y1naf/ZgUwuCXZAcCtAKndxL3RIDxRyHc5aiBjl1wiZ+JPKSvBu09Jtx6oYjHDH2THkvlTcKq/oEmkG/I+vENGD7+0TaPe3PHteaOr87S8dUaOQzocz4Pt5+Es7Pm6Qb8ncYIXL6Jy3T3eV9dKFSviJLFA/fBXe98aaGFWvo2pEuYxrgRDJtJ6k1S+QiunbZQR1CDfrE5S6Z+El/qJPvZulsiThchMOBLdUlKKIRQ1wPqjM+4Fl/y7vpR+g29bMcONcQ14KP/UxQF33s23mePCI3/wl6efvQoZb+csYMn5wfUqDLUSHvMjquqgF9h+jdh957vPxsfjA+eRXby6G8/f3j7ZkTf/ATZhXrMfDTT3sGT8T57q6aigL2DZ68PDl94PO093++WiL0vOj0I9X3R6fui058H8f/aotPbBfU/+lx3jWhwXPDBg103yxGbAvbg8WrDX+mv1sD/it+/DJaHTJWlkvhdjHkM9wTUIwtf9sNXiH6wJoARQev0TRha/ZXNEPwCWyM7yMZWlPBnE65HA/NCRLtmxe3iyF9FOy+XYq45zWd1De3RaS2tYdX0d8hiB2z84/zalfxrFFgRs7hlodEUotOHhbYhwGb2LQAaHWntJK/dR51qlVhSJs+FL+nj1HQMVPVB9ThPLO6V7iEbDglft4NXgNWAlsRctzayRx39TXRElL535f7hoINk1x94kEa7o/tzlBWqzpuD9NL9GcwQGC7OfcbYACbe+l9JNc5anxq3RZCH3Aye5+f4wnkYMlRhUzo9aq014wfjSitHms3NPDIE/8vup6tpKNU8/SeOXn5Sal4Ardjv4Hfs2CGT0pCKPD00MXIHLB9HwHCp1+zG4MtX7nUyR0graTLirp4mpiTF92880wYE1plrUxpOZvPZPefJMbx6Mv/BOPlg07k8mxeFsKvzDZjr1V9tOquntE03rkflm85D4XYbzdF6dQ0/yFV2gVTqGcKr8PfA4aLfMP+mm1Xhf3NH2yyUtuckH47YjBfGoZLLbKF0mG83MoM1YjeCxQalxzou7yVGGoEyjKYEVcOfDG7HmqlKPu/Llmtnc1+lR+mGs3a+3GzS209X8CkUxrHMD7+8+sVpOEtmFSt55fisgX/rwdJSN9jVKge7WvSeOFwxAmEcKNfJu4Zuf6a/BgY5cfpCQq3eCus+D0mH44RAsdH6EHl6ifH65VmaQyNiUgxkZrwqi7F/j/KqufaRyEruNl92rKwE+tWUvn5rWqbQMMRUqQK43BC9swYj6H5rtr0/rzLjaS2K/pT9HY2C++HBi1cH+z883AycX84YztDuXOJ3/aKeulswJaL4vf9b+mxg4Ob3qOC0tZVmUJbu/NWcrPnoWm7WAvrqfe6iu1L58FG/0QFKMFAp35V5cKp6gG/edqZTlbOPJ6/6E2HAfMWzu1tUM2J/MpX32OxnThZsRf3JiEVdzwo3m8jz3JJX/ZnQN0ElIu9qumTI4Tk1YC6aAXu3CG3GXYPWHKpCrTBw7E4nbsZdMzGmGs/q4s6XnAy8ZuprJP1tJ47DXjvtsFrz+fPSuJ6dN30tel0tBsYN9dAjF48XtiGum/bMuAnLhU+bKlahsHivTQJbr3D/rgp1Ifgur63KhcnUZap+/7/0K3vlf1mx9D2W3CqvvZ8PDJXKPA9HHHKdAcy/NyYjQ9s2eAPrUTD7UbKVu54HABLj3/Cc4iqj4zo7Es8W3lm3QBtodKG2a4yDCCWaHRJyltfUndxybeuqZb5DVU/pkvLVov0L3cUV17wEC1iBZwposXL7ht3CgcKb6IH7k6KZRI6gGbjE8jQV19ZQBM/J6YilHRFEPkIXOTopWiBxmVNtfrRKDaHQF1GrtMrrzN4ckR98ciidXT8MEzMW13bVtLcml9a0Oybasx8lMz++Zuqkv94NZ/ad85LcWFp+QgsmFjHpphIHOEJU/41n//j+DVu46xWWJ8DpPLUiJFchPUs7/w9cBNbM+msMZQ7ro7oJROL+0sRruwBpQxN7H+Ia2NrczkzbRp88Saddw2Z6hqE2ujT8UQsNueel1+IwVOn46cOPsQcCRlXM2CUsRFbAiPECtB0xq0V1XlexR0kUA5jj2bXnXAUWux4u32nw5FVrqi6pWPhkezOE+8cVMxynwZeONjHuLLlGtWbF9Z9nvDabYb4BoD0CzGZwJWu5cojMiks4r0ALlbPWIG5HzrGv4ibjzEFmbrfYLQHRqrbw2d8j3bVGENLCPJqyrhrBWFVdLeyu/TrkFfftrDcciBr+nBub5qzfaqQkV/lW3ztSv8nhaH3cs23f5ONKdXTHTmr+tQMMOvduAsEN+ULr2+UCoMgWXOjzqaKuGZ9Dl24dm/LC/hCOx96etPHrzz2fOEhsDdJdSpIBcO0gqByeu83tsasbDGHhtitZAO96oTYkjERs3OZzLzlvv5FhADRsfO4YIgNp4LxKSrTddKzPOeFT4Lp7pmaF4pt8q3KFt4Vz/H9zqzFMBZD7AZz4PDeQfc5A1eJWX2PFJMvL6jZnwXGWcwN/3OYo0reW29rccvczJedgPkO+qSyrKy6z1S2/z6Hgq9usnWstLnkx7g+wOVcPY9yWj4Xva+mb1tjbQpKDu/vWGj5rPc0ot11RM8LnrwmpM3QdNQtR3ZBEHvyfAAAA//9fxUMi"
+	return "eNrtfWt320aW4Pf8Cqz7nJUzQ1EPy46jOT2ziu0k2o4dT6RMpmd6DgUSRRIxCDB4iGb27H/f+6oXAD4luJ1ZdvdpiyRQdevWrVv3ff8U/HL107vrd9/9j+B1FqRZGagoLoNyGhfBOE5UEMW5GpXJshfA14uwCCYqVXlYqigYLuE5Fbx5dRPM8+xXeKz3xZ+CYVjAb1lK39+rvIjh77P+KfwXfn2fKPg9uI8LGG5alvPi8uRkEpfTatgfZbMTlYRFGY9O1KgIyiwoqslEFWUwmoYp/IFf4bDjWCVR0f/ii+Pgg1peBvD0F0FQxmWiLvEB+BCpYpTH8xJmp6+Cb+WdQN6+hL+OgzScwStH/6uMZzBPOJsfwddBkKh7lVwGoyxX9DlXv1WAiOgyKPOKvyqXc3gzAkzQR2++o9fw9QmOGSymKiU0wYhpGWR5PIlTRB9AH9B/bhHX8D98KDLvqY9lHo4QzeM8m9kRejhxPAqTZAlQzXNVwJdxOqGJZEQ7XeuGFVmVj5SZ/3rsvMC/BVN4L800tElg0NNj0rgPk0oR0AaYeTavEpxGhpXJxnEO+0dL8sECslLxvYVqHs9VEqcWrp8E57xfwTjLA5iIRyj6vE/qI8CEm350fnr24vj0+fH5s9vTl5enzy+fXfRfPn/2H0fONifhUCVF6wbzbmZDpGL6gv8c8PdAZIssj1o2+lVVlLA98MAJ42QewoLNGl6FaTBUQYVHAmg3jKJgpsowiFNYzizEQfB7WVNwM80qWCoew1GWlmGcBingHc8TgUPki/+5AkTQfEUQ5rCjZYaIAqwKpAaANxpBd1E2+qDyuyBMo+Duw8viTtBRw6S8F87nCWwsr3KcZcfDMJefVHp/iQc+qkb4s4NfoJEinKg1CC6BrFuw+C3sbZJNBA9EDjKWbL5gg3/CJ+XnXpDBGLP4d0N2SCb3sVrgkQD0hfQ0fqFygxScroCDPCorRBs8UQQL4EFZVQJ6LNV7MMBUMHku3CMY8c4CYIAllTqED/uJmwtTT6tZmB7nKozCIbDSoprNwnwZZM6Bc0/hrErKGPZAz1vApsQFnvipWtoJZ0M4JREsDibKUvN0/UR8r5IkC37J8iRytqgMJ+sOgEvo8SSFHwfhMLuHX85Ozy+aO/cDwIfrkfcKQ+kwT6DC0VSv0j+s//nE0s+TXvAESOr8yX+5RxUWlDKlCFe/Ml9M8qyaXwbnLXR0C2ilN80uySkS3hoGsJqqFC44Lhd4eJB/lni/jTXtp0vEeYiHMEnw2PVgnpL/ANLJhoXK73F7mFwzJLNphjsFv5bhB/hpBtccENcMH5BhzWP1wwncPx0lVaSCb1SIbIDWCmOES+B4RRbkVYpvy7zAXuhCo4X2/0GWKkMWU+SRQCeGHRNlI/xhnBSa9hhJMG6K5yRjBCFszvr0eYeLJXeZ9xR4g0IKxMXSSTVLJcaOCEiFGoFzlMDNcM/1Yi+Da55uhIIAwEOLpnOLB7Fn4esjKQQiiAzhqb5zfq/evyWRRC5Of0Gy4wDoCS4lhtsusLThMt8oUxp1xHVJzgBSYGqBwfF6hcGA5ibT4LdKVTh+sQSmPCuCJP6ggr+E4w9hD66rKGb6ANoewZmEB/WmyONFBQcCMPQDrLMMi2nA6whuCN2CMj6IROSMQiOt2NOh5lPAdx4mg1hzHTnPwF9VGlle1DjVK891/Sy90XMEcYRHBODImXwAK4zIp4An5EDEpoovDV1rmQZvMkA0SgdagAtHeVbg5Q8IyPE8DeE43vF2x9Ed7QfuhCDDYRovw4vx89PTsYeI+vINO3vQ0n9O499QvNl93ea6RRJlwqb3FnSvw7EkMo6jlcuLvOXh/3exQJFa6Hy5HKGxg7BiforZIV9BExDbSGyBj/waPy0/T1UyH1cJHiI81LJCM3C5yEAW5wMNRxHoIB2JGFPjRwVOTEwJiUSu08Bep2oe5qGIILJ8oB2lItY/FtMYjltjKnOy4SbFyVC8dtYN9zAIvprz0FKZJemv4NqA1SdqDKrSbF4um1sJTM/bRdyoLnbxFl5dvX2a2+EEIO2ES8BxssB/DG5RFCymmjR5W0Ua53fxNu9b1KSGZxus2meZxGUKGM48QlcYEIO78XbH6gTgbf4MJAhUCZoodsfReBZlswNU/5uosT6yazC9QB33OB+dO2LMKIlrcswr+80aQeZK3kSCi9SYBL6Qdy5O4zIOy4yYEpxOBXjNP6CkkyoSqPDUadhYQMnVJMwjurjwXspS4Lv2eb60hjFr+vAFsPxxki1QQ0OZzhObb1+9l1H5VFgwG7DhF/i4AxlxEbhRjbiCz9z89R1oTaCclE+Bl9IsLGnDPVpmIII1pmKNFq8Vb1ItZ+WkritUirQkoLEEOnVahAQMaFsZkJi+m4HU6clSgej+RKvpWf7ESvW5GqvcAyWtLbBgMUN+FhmUdxZOhJbBSAZ1EMAgBAgWbJFss53ChZ+laSEiPQGenKqoECEyqhX+4H0A79cq5Q0gWZClO21ECVpGswiGq7gxJnJ13rBjOmRafTVKL493oicyZgpi1nxPoCZcKODnZTwiKR0EF7lS1EcWFnrMwb8wrF1fLPDYfYzrBbXPSva4UpWTtF/EZRXKfgA/X2ZVbuYYw7I09cWpvtdKNclykPrhUc0RizJGa0OKsq0QLttGkGvCnpZIH4hTRBjwo8QIXSB25tk8B5pUyXIHqQ5wAngquhLoiNxZhBfikgmF+Ro+AwrmpMqqAoAncqZ3DMdeIFoKGItsQiACF6Q0X7/vATeKshluAJpqgiqNP8KDSCdAZH+1mJU7gowWViyAifJwoWHShH/Xly/uGGX+FZeiBmBvsKhiowWroHf9eH6HoNz1Gaw7VONAdYlExmABAQQ5exshe5Ed07syXJaq2HCnJJmR9Vm18F/z9uEb/IHVCmPZk/1AvRn5AasD9fvl7OWFBxgvqoPbTs4vj9/35pyorD8CbXnQkWT6CsamqRqrfwvnF0S/pAlOhvZPALgrmN45UrKZrAHfuywH1noFGhNQYAuQFYC/HMRFNhhlUSeo4ymC65sfA5yiAeGrq5VgdbWbAlLrhr4KUxDkGyAl2ciV6VeBA48O5lls+JJvlYLjCFdAxLwaLi360IDg6P8ET+DkPrkMjr961n9xdvHy2WkPvgpL+Orief/56fOvz14G//eoAWQTX4/Hpn+G43+sebHzE4t7Gj3AbFn45hsYfpuAaAMXdA4nyGWqaDgE5k4yh8M8X2meaVQbpvA459t0BDQOQi9LXiANAhtNq9lQ5T0S5aexlWsKMyiDlwTz6bJAr4AxrY30sS4cEN5lpeM+IMMhGmwr0EyJhQOi9WqbCsAQ1MIsPY5Gjb0BYRfe6PKk/UQzrDtox//6ahVcHR01gan1pP1rBbqSj6h4vgEG84BPnNfvzQWtOSJdFi5lsRUA7SNANMamff3+/gK/gH9fWMGjdteCvtcBbt5evVoFtTs5i7Q7XPXeJO/57b0u9nMfDrhJ9gUCXl23RDhkeR+k7jjpiHsh8wpoAo3xFgBAhk8GHbJQBOKoCHAampZYVngPQKHdqIH+qwTYWhm8QVOEEoHKg5ek9n5nltamtXEslnWa2BhESEs8mcP1hDJmfxWcHSLWlYR4siYQ07CYdnY1MqZwHvRQT/FcwdnIFeqlnll/zBoIPoh3SpqlS9dJyGK6w7SAZMRkeUerQFM0ag70AVd3Z1xJ8O+Y9wpN486cKGuAams15kC7fmtcTmbogNP9WGO6VZ20DAMkGJpQdXQ73UyRMbGYQW6eOG0C4hzJkI6kZ0fLqsg3o+kvVlvROOIjYPKINBOmoQIyDY3z0LiBrYOLtWG2DmuljmzEqx1a4+CtKkHwZ0Nz4RqyQwyEOWczNlLIWJWjKSiAKGU5o4PqWYgP0QKJ1OW7vj0fZlwYA6kPgowLUIhzMlczgFk/HcDrBdCEM1MdMoYpDMR7phfk2k3kVZEQfS89D2oHIjehTK4vQhw2LiyogrBd7CUj0l+648xHtxZBPBe5R/NJmMa/86GPI+PyllO2DKJ4PFa5azMhOTgmRy8glY7nMQYNwIAqvY/zLJ35QpSlratfbszkMWD7uyybwMkm+g9+/Om74DpipzSZTBsHvik5v3jx4quvvnr58uXXX3/to5NvyDhB/f53axZ5bKxeOfMEOA9ihW0xRNN0VOwhajCHqjhWcG6Pz2oirXgSuiOHa+1Bun6tuRfBqg9hHdD4+Oz82cXzF1+9/Po0HI5Apztth7jDK9vA7Pr6mlA7Ajh92XRZPRpEbzUfcLxXa9FYnvdnKoqrmS8l59k9kHn+CUQd5gB6wr4+nG4AVrgAVTn8He6RXjAZzXvmIMPJjOJJXIagyqowbd50i8JbFmuJHS1KlMQ9j5t7HTOjF+zrK9n7co1zyzzoOzDEs9CIj3NCduZqBFxN64gGCjbPiw9KrPSwd84gTrClKpSeFx0KjgBJ9xWHr5qhC7kJ0yUiCE3eO1xQnch4IgTbxceRf4bjGUaDfSI1gCYzplEGCIOAhlWclHidt4BWhpOOILOUJXCFEx8AJwJ0/exOJOiaWNA6s6VJJaxyQyBHB2u2xh/DTZhku2InPDow7jScoPRG/MTQQYOTcASqw0YcL5rLSF7Xvl7DSpxH17tbWXp2niZrKpt8TvxIzJYxHQ/rJt8qcx/xrX6Ovj/PdbmVA9CKsRy8/UgOQDMsOQL//3YAupuijYUSpf/38gK6x+DgCjy4Ag+uwIMr8OAKPLgCV7sCnUvsj+YP9EDv2im4w2XfiWdw5WIP7sGDe/DgHjy4B/9w7kHO/65lgK8zHLxVZXjs7o42LUqGeX9rxX1T0kFL5vjD0rKcrHqSvSSiN6PFYIZ8P7gDfPTloTtO4tFgWAonjx0S5awCBZ5SmegwJI147iD4BTVtIJV8SRHqnMNlyCgGhRozOI6PRaPGxEUBiJL4k3gyLZM2x5izGnpf6g4gaAlenCDVq0kuceNh9CuCqq/M0RRukhr+Ay+5tmgKi1SIwKWcPM88K/Yb88X6PFNrRR5RUpKEuPOAdI7QZvwBcGPw+DOnGMw4LYqfI8s1Z1Qi8gCb5IZFNOvsUuJRmHhT2FRMN78DXccqGVvvK8bQ4+g7mJ86Eo8JmTS4VhHYTKgEwE9mLW+5PVsgcPPXV4NhcthbF6uzsV0au6/lAL253zKXmfe3zUui0xnaHSXAQm0yzIziAjxaMSR5RenxfpIRko/mKUhQuGVO+jBZ/qa8j6HNBtZM+gebxk+MRac2U24NWovhHe19wm9xIDOGzYiGiewiZDw9VKgzbANKItWBFhI+YVOiWHaHW5Yzn0QE10ka2lSLSSeuSNxj42VLXtUQvlIKZ9L5E8A9w8BLlubJJCWJc6RHSYaXPOBadmIzullZkiFnaB0FjZvMSQmNyPkq9NFNNCeA2hHtPKbTuk2qtod1l1osymcKoFgGyOQoH0aGixzEW4K7rxJMHyIPf2xz4eXhAoUg+ECZ8LsEe5Td5PZx0QDe4VE455IQkgXpOwYkKdYYOyT7zB7A2Kn00g+uySVJu2eliyls9x0/oLOO7vqNsA8663eEkGNQlO56wZ2Q/DGRvKKvMAnyeJQrJLQ7TtXRdVnMiCYBW1OcrCzGeWZk2Wlekih0Hc/DokBkHnM2ln9dCOhdbMcbPgwyQx355pKbgkwh6WftPJA4JF2g48aumDFpdyjbrbY5TBCAZdlTYB+FpIFZQ1VowDRw2ZG1dBTqzMBfwhwPN9U/GFcUc2ZEH4ARRKFesFABaHBkFpB4gyA0QyZSbCMcjdS8pBxoCUHgO02LTj0Yg6osYU4jeaVGYdVuO6OdJv+dZQ1mk5myNuyxKYBU30chch6kEcXWXh0JeRIVDDJrxmxvpFmdas65qkvO6WuUDBIiYQESj2qMbH0kthdb5Mlk/jlf2W0VWL3UtFU1mUytmDqrgE2eYWSFzUUkAyoS0SKz9ZQKdqeBJtiUkvlI648j66Ua+VWFAOoRuSTFupOA+K3vKsKT3HRSCIpEeLl0bKCKd3XQttCrupoKFnESFoTG2VrKv4ZklsHFZy6uwBni6IgkWb1j+FGHgMF7H5SaB9WciZVecqtR+VilFHSC1McjskwW8wAfPXdnrX+wRdtGE3ehyi44mWsPkWlqGfpYPQiOMtvz7+SZu+Apcnb4KziR6xj+/hLpWVvGubIECg9BUQ0t+KT+zLKogteJ1XnHzuWTLBngDlY50hrQnRSRguHNpK7CzyRif+JpcFMFWnq4yWJgD0o/ximq8m38Oi0+1dqbcTqvyoH+MQ1TELRgxVGr2/XotbzsXQi4XOdFvxAEn2m6cWnx/Fmh1AfE9iHNFqlbDs3SWdl+bvWhpNlT1r55dCewyGgN6TYWxVXs14La4Lx1pkuD4j6a7/HKunedR8iXsTCfLg1Uizjq0Kj3Pdrxns5VDjpCQQWCqHAOyDITlc/zOIWDAfuJgQPM9YGbDNHHlaBkbxYQgfyaFiWWwWONh+wKsMQWk7sO2Wz76+qbV68/mdJ6/RpXY+JZHIF0m9oxaHroMi4ax28vZSa3MNYTKVqEs4UIUfUYPYckNc32nKxzLs8mypxjrVsj69Xkafr2zo55h6xJoSQdJmE+u/s8RTQC0jdTEOft+sYS/s7+3bUlc7hUkKsHeU86o9VvMMCJroXVXPhsWfzmx3hoYauLpf8EHIQsKrroH6ABhYncUNPPIuSs4SUrxFCsLAanRX1UzPOjbDRwgodBSkVKifjGJhcBCYQqzEdTFVmCxTJIsSnDlONVrO61NHo3YGnpronJG5Cuzr4OTl9enr+4PDvlkN9Xb769PP2ffzo7v/inGwVSACyAP2G1M9gd1gpy/u6sL4+encof9mSilbeoRigaok+NBIn5XEX6Bf63yEd/PjulMrBnQVSUfz7vn/XP++fFvPwz8Fff0QknHQhIdcm+ZIpVHMwrimo1flRDRmwlsoe58O9Yb2Sn1JEuO2OtLfygcCdBoRToHIdxAuynlSeZEbfiTdvzJDPu9ryJYfZjTuPiw6BwDuWqYzpOsrDVkPoTjBDQCFxNL86QOH2x7anqT/pwRJhwQVFICEQsxuY47UT9IdcoKSCirLG8hsb0/grYB2g42YL+Vi7i6B1ZXtCrSMNuWFDPGMdQph6bRZziXsKha6nMhiF5HC0jvkksXoN7NuNwSqxkmprqQqTuhkUBR6RwACp8DRCHWIScsVwopJ7ULoOxJt4f9BNJ7aSa4FrAgpzQo10jFW7k9ZqdzeydHr521/8y5SgoK/JpNdq+IWQ/U2FKTBS+dtRtI54jDsnfggz5yJp0QEEVecOxnpHaG37A6q5o6OOpYqWTCNMCTh/Zihlt2rVWjz/7qoZD1AoeLP6zbrFRARCToqsCeEwLVQFrmlmhA6AG02HS2JFzo1o9yyly6i0JzQtW/3dqfAZyF4tPQmD2hdQEbU5L4TCRGodVUgY3ywLvemtvcBjNNVs35lI7jTLxFnHh2i2uLO81k/KURCiXZEpMs5RM+iD38+RP3lR5NlcnVzOgoTwKZ0++dI7rcJire/Yy6Mdvbp98Se6LNPj++8vZzBI3RiPIU8enzy9PT598WTu2XVUp/EkxudBtI0J1xS4ysxapCh/eZ5RPaXIJbOVvitVAMbTvVglGy4PrWPtWf15bWo/q2tecMAGaWxr6CPm3sJohEJdvDhU/Ef5KrnPt3SBbCLFFWzYPp5P63Vp2A0acjWJbnpckMl1Xzyv2hnllaXQiZhbfIUYbipJIBsjjisps4acpr7VcigHTaJZDtP7nt9dv/0tX7y6sk0kycqkAH3mhWbDRUkQzlyIEwmJTKD5eW0+jDr1xQ+7ik94ydWUVD/wh1IXnCUTMK+N4VvJn1NhXpHD5HTGv1zT4iiw1Tp9OapIIzV10lwp4RLtsZqmLFyZRA+tAwtlcIojAg5CEhktGqHm5JcxiLne7iXrtLDzufR5TUXUOhkPW+d316y9XI9bSXNewuBm3TTjitBFy8YhJvxhx4XWH0EBof5bLp2q2hc4SfxEoBx8ISjYq4WLyC0Q2hKOLsxc+jI/LGMR4RBIOLB+jRGrMIVuknSUa8+2AExyRdSRvZvHNw7Ir8+p7GFoLtU0aLUDs32LiVZI8LQ3HwJ2mdCj0bIhNJEPdJYwiLbvd4VgUrEZ+7bsva+JlmE9UOegQFbc0AyGbJI5iOUvi9EMtQrnDxHhCF9lFyf/Tw9Y7JGQIJDWMVJ2x1FuJuyRu+jNx09yq2k4o1dObGqtlQnZjnyYqcwW07+TjGvkMHnEj60ZhjkqarXsSWuuvzglxS7yEqSsj+U12nDQST9AToSyC+82Y00o1mpIZ3pbtR8iu3zuBLuxRzI+LCrulGNfiVsLN55M599lnzX2GGXOfWbbcZ58pd8iS+zyz5D7HDLnPIDuuqSzo+8t8sfoGuzWpOU7gLtocSy4iryPF6RmJAKfmBwrWGZrDKVKZ4/Hdp+TIZ5WG9Klzj0x8QlZ48dff689rzUS6MI5nJpLK+OjfnFclx/pKFSfT1enVDQe36tZM7QZLtyuTNatwDyZboMeP9NeB0iQWkpjSGuHrxvbiWgmvJphXRpyGeYT9r3rBfZyXFYYScwEm4GGvqVKHUwWHjFDBXyrgZ6kqqUVPpHaqb5HD2NhCq8q7ONU/znVkm26m4MzXOOcfX74YvLg4VDM4VDM4VDM4VDM4VDP4b1TNAO/PrrqmfS9ju1UL3ZCR0ml3p32uC3FLB3caMkwVns3w/OYKbicu0doognj06drcsZzjFla6KgwedfiS9GzhjOEeucjFm27kVxRx4QamYASJHl9b3JQlZYk/ZpcgYvaOWuQRpupY2K9SBUlA8by94kA3FSa+l61sn7Mr+ny3ljbJmCZJ6kSVDkU6lPgzFe3iwA5hkhTU9Rv2W0LTuA2w4FJfXEKBc+YQALHO2VQjSuGmvcbOX+jGhQciymZF2ZXIyDL2DJ+vbXxW9MfhLE6WHV1NP94EPH7wVNv6chUBjrBe2DAO4VIa50oNCxC8F3EaZQvr/rfV7ejJBtyAvK6grsu8UsyCpHzt89Gp4joNt10EBUoFHLzNfg3vVX0FH1Dk/2Rr4NkM2KRzYXB3UeZtxUkv+hf90+Ozs/NjSeKqQ9+hQLMC/zpS2cH+KoT/ex1arTZ/Koj1fEL3KBtlcOqrIYi31TpaD/NF3KD11lII3QG/LY2cnfbPLvpnn7QlZ439Yk/DV14VYekLK54Hrz46DkGNhe9M5eM7KvB+P+s5AjAFWTuyrlHWe27bVac2uOvxsHe104mzeWcfHcoDHcoDHcoDHcoD/bHLA03L0rPif397+37n3iH4kgmH7etiLrDJeXKnA1MVB047jS0JyDzR8Epj2u3t+fqFYRYt+y2VaDcFZGysRnvjxWf4YAY0ayPb7OVXq0GUYJoOIxOIMdNmrIXye5UkGSanJFE7tB3g8jbDaKZiHUafIrB02KcqRDmgKVydXTxrRzDWXck6y+nzUMpT1bKVmcg5C4BquwCDctIDgPKTbKFyStBGFqoLRvWDGyU5sdmomuk4L1v/WeqrPLnWYfUo5b15dfOkaR6bKFDK5lToZV6VrWiiNs15ZwFbP8nwNnvGxVxjN5H3FJcnJ0PgW335Fk7J7KQGezHPUlB8P/U552m3PegukJ/2pK+Dc/VR1/B+6rMu0O532AVozPusihZT704xeD76eMx24+7F6cXmwnaPl9eNcK1Sj8/6brMRXQdKLu8f5OPGu5vNS6FXfiejjE03CWebS5gW34W6+KNOakKojMNDKng1chK5iL+X0rwIcyxSc0fFzPCPuCX9E378ZGm0OjnNS9nCxei02rBekoBOufOEI/6OuXZSEpfsaS8xBQvrU2gJdR7mXp3CazZx5qEtE3gnw2oZjanCNYZSy3ld2AVHdPPv9F7IKG7aZy3rUxbbayxIp/WaMafhvTJpRlhOTcKOR7rOIUcTshFApXBaqSZYHqRqEWD1lIIaut07CgmqMgmmtWGOmg/yQ7OSAUJJOj46oisfr3XXDjzUxi4SDB6cnEyeNvJJvF3K2TeGc06McbnBO+erDcX0dFqNH9LBppPZrEoF/xwBDNjNNQex8SMB74KTniMhGYXbYEg/sVcAiB69VoOjnjCkC/jsEoIx5+YYHSaVXLGWhpUfUg7GdWcVDjfPszIbZYlfQijMhzEcwtxa+QNJV5XUMSoVWPChmMWYTSkpSz2iwDABOsXJlnzy7cPFB1iQtZzFo9/gjIYjNcyyD3CeAZ0lOygAmIVbKQhZjS3fZItvwr2VRk6VI4qO5oaGJpIYr9jIRA6bMgh8Ck6w3GBw/Z7DpYseFfYueoEz5gILD7AQ8hlK4WE867RFyhFLVyxVAVWkBcnctCPDDM8NoEfqqnk5+3dSMYrelFR6t9y5/l6X74EbUx9W+YnvrtjuRFHNmgh49uJlLR6YOEi5HHTXjPKKrVZUgpOSx4hpO7Xkr99zBUihJqC7BUjGwuRscr8cPxuY4PO/vkkwD4GYsuQ4BPBgkhFKj2kU5l6zS2sSA6pzN+MHBaIJp6JjFqVoQRPgXdWQ9B8kECp5dmKQdxxHxyirtZTtvZz++I/Fu4vv//Htd8/f/vXk5fQ6//f3v40u/uNffz/9s5/Jp0mjA/HmyWs9uJbTNLsGIh3DDd7/W/qTwvVwUSV7nV7+LQ3+ZpDzt+AfAPPA89MIvocPwP2dT1hRJAdZgj8hBdlPVUqE+zf4L1ZldsecAftzCgdLC1e8vI65q93M5oFK/dieuZAcwcYd03AuHOaoCCg0CRd/H6tFn2FYMbFGDZY8AIlhpmAZDIgH9HYwWUA8CPBf8lrIZO7IZtL+k0ZnTsa9RzfAlECahl0bPCTOwOmKYVLS5bg6P4mADEfxY0sFqq+xNMpZ3y+JEodpOOBIpa6yBq/eXQXvNXd4R1MFT/XJXSwWfYShn+WTE76YqebsieYnxwxc84v+x2k5S5x8+RvhI3Rf6eok+q1C+A9cZ1ipgjgYSTwg6X2L2ahUNI3+EuOsLb6UTbTWV4l1tm1NDYS/+KRByiwcDZdBRg5NKgKe6du3sNFq+l6qQ/sdGeh+AX3hERuVyIUrg+x15cq7LZeu/aXl2tU/WvlMLuD2i/f8ot4Flra2C1X2h6+0dmHvTAqfAGj6dKP1goQo6ldYQ4+RhnevlXA/P8nNuEKMJ1xD3QUKb5DgQf7Qm+0wMZbayWsa2poPKvgLzxN4lSflsrUYTsIlMqcqgj0oR/B/8fz+xXE8msGfqhz1v/z8MA9gfpIQhGu+dH68uaaM64Qv0YUbKqDJ+gfEYh9xd8EYdLSkOawNbuJ4Rgj9/NCJQDumASlK47Vy+NH9bl2qR2peb5YFQdMhcEah4J7Jg+WQt4ZKzXUkTEFc0O9hTT09Pr3EhUQ2j3js328iXDlFWP3kVhMMAvI87AlISzrDgwelLuDk2Jal1sqboGN6UtkWIZirVKXbIwDEnHGJ0zkVzvyMkzHcIIswSQoMUivziqJ3GEPwF8gNtEQaSscfahnSkRKxEjdcmppUF2roQeFMQvHeCVZdahsaEXn1/q1go3A7nWpqcA04IVdpXmG/EQbFg3PESLrsufXfeJ2FIYVCl3VhciiswLwGxbqYiu4MwCVVgrdiW4VzVvHAwZvbHyhHKUuJarSuJyWc/fYiQk7a0oTdBrKSa1dFiur2Cz6oKSt2x9ne6HTIqznk1Rzyag55NYe8mkNezZpkCTetxty+j5H80exS2j78J+s06gmqhwSHQ4LDIcHhkODw+AkOwGRAa+vWYKz1a5lM7vv+p0m0mCrTQ8Blq6bZyrpy9ejHpQAIVAy15KQN0XYkLJrQb4u60a6C3G0moBVPisKJCvpnXkjrro9L+iNLEkVhOqzE4l9WBW2JjdBj1gKzHO/zYyLVrJxncMPT+zv1PH0EknIYiw1bmoRp/LsV9rWZp/79hjgQdxyt36s0R7cBEQ4p9qt6is3moNjbWBCWVz2iq0VquIEhtmfoVCVzKrYd5jlWI5U2OqUUuXV68YQpB+mQx8AP0Ddg2PXsUpLj75CS4oL6yUrDuPRhxAPL1T1SMiz4hljwFpV+ULTymgCsIJ2sxt23jz78Q0qGf3Cx8A8sE/6BBMI/sDT42YuCjofUtOgQLvfe+WrrJtcrmZvpxtt+02FEnLntbLqd2Jz9nnQU2Gia+8bRiUPLElTixdUSA9adUftzSrsbwxZgpNKy0KWOdddd7pIdmq5YJCDOY3bUUFJikg1BjLVF5zW41qC0XamrSdFZDBiIC0sJlyAkwWTkSHPtZG+p/6PIE7w89EirUUnOk7iM7718x4bcKR+Pg8JkYx4Hx4n5E7PuzAfd1OdFrX65GlXU8KAjVFwNqeeL4nBd2UGNFTt744ScVEV+MozTE722T1GiUk6c3EJeQD91lMAWnhhqDfBP8nBmch2LGK7msKVDbx34+caE0FWRH+/NaasVnZ5vJR9uGnYeUnWX+ugP7W9yqzuVursufUyaZvvz07MXx6fPj8+f3Z6+vDx9fvnsov/y+bP/qDXAwLZXUf9By76lMYLr181L+/zivNYwpUw6JziapBaGguii73ucfMAUSO5LCdeYu+SKfheOrh7appblpZsLrVcJ/HmYww1KJgGdsyFA6COK/to5Oitt49GMm7/7u4GeUBhgwGFHjV7Tj5poJnMFZi5tVTA3W52JTAFxJ2HCLSNs6pb118tV+5Pz1dqr1ja3Udw2XNcLHYcjbJOLd+Y8vs+4e2+O0Yt4VcZq5LSLov4oerPJbkEPFPXGJhKlXqDfH9NpQKVF2WhEHnvUOLGEpfRVunVBMM1CqLwimlZYsZv1WGOlgH99RVGHKJxCF4rKxF9E1ypmpKG0bmIDKCslDe4Ei/07s5Ir6pObq9LYYRBD1rKPKQA2rQeD96nMEHWlN0aNnoRh9iwR6AC1XjBKYurBpR9FL6COWXLjQqkMB6ntmPRB/TEw6tpGTBjo4/ldj0WekKSQVJAmtQU4CBCWAKLJfYz+rB6ao2B/Sso7UYZ7xyVNBmwUNLDh0sTSuFNdhv1hf9SP7nbR/rdpgtHuU7lKTJoahpzTHmep07fZVbCbYTk32wXlyHMt6TpCPFKdwcSIAJGkEkA0NvYxiXLI1QQDTil8pCi4G7d9vuCu4rEJcUQpkCNMgVadrsBYx+X21XvTmYc7w2swGbaRivGzIChOYyr1cPPXdxJd+bTQJfO1uAwDWlj6NAlXbDExsfWZpAptsmzgwyk74ISmp4VuPkhcQWJgMMeo0r5UDrBToBw9MeM94YLFYyPtuVCkNcALXeOLfhbp3zSvbSQ6aVYi5VpHzNiK2hTuOoQh3XgThNRNilYhI9oIHS638WuVjqx6wSdd3m4bzKLWluKwQ+Lp5W08Zj+6TiWVJ1/x8Cd6CX5nE9aGgGvBz8B0MadCYt4lWUp95OZEws+sooIaFJYYgcfuY1wu5h1bqyMsVOWkn9l8Jc2rcjPHGMOiTOtsjv8awbImcOMxs5I8NeCMCfriqaUdPbYi4wQRBmpGYtgGsKo8m+do/kyWu+hMzMm7EofYhs/N7nhjzNXBuY6awcyG8aTKqgKAJ2qmd5ykLLzSjNBOHoMQ2TjcGLocHpeOoSJ6WEQZuxD/1WJWyii6FUL4VKFOb7IDmO7v+vKFpK76YlyKN4PNK4wqjhJjde8O7x8qQdNnsO7QnIdXFmWS6vLStl0f3TNxvZPjY6d1fUP5XFT83GbEibNFGjnT+WmaNV76Yd+8qC5KzTA0PH7/EMl2iGQ7RLIdItkOkWz/jSLZ9gwkO2pGkuk4MktZrH7W3LQgH9xf4Bfw7wsreNTu2k8WgNYW/faw5LH3kjW2z8Xu28S2yENaCURGhTtWLvFQvPJQvPJQvPJQvPIPV7xSSovULWj6qw3BTrowSd0eU7q/ocGp0U8IZSGdYxWiqxTU/BG5V9YGNIHwFkmRJ02dlJfNZGkqcem58UkdM7C9uUDNp2qGZpoOy2280XO47CkTAVCD/xSODV731AMcIwf8Wktx5LSEIMsOGt1yTEnLFbmrpHrNnQxIpw/71aP1qSn6vQwvxs9PT8efrjlEfe0KkwJTNqQyxM0li1WCT2BiOoYuPdRJmv8s/IBehxJrOhbxkP1EhnT81H4n9ZFpNlUNgmprM6Ft9jnuE1aFUOmIfFNFgX4JsgviWLmKcAHSz8ua79mRbpORxXQWR5y4b4MZSOXSxM52M5iHOh1Lj7DGjkbPvlLP1XCsTkP1YnTx9Vfn0VB9PT49++oiPHvx7Kvh8OX5xVfjF5+8gYSmcBtLK+e/JZzWa3WtX6QAW6F9uo3I52GqO2C5GNKnFplBT1FP+CaHpGEVuSU+LRjg76ZwOmt8qeenjL0KEdKRwpw27jLiND5JuNiZgIfbCCQBmwtnFMs5ScUp3lvMjs2cYnTobyrayZet9NoqLYsNuCiLLKUWGiBZ3JRCDch4k4RYgkd8SA6aaQmS+6uvaZa3qwJdSa5WxP6Lb1RYFs0hYFMAO6B8h7Aeqgk0N25Qgy/u0Uwc2RoOx+i50mOY7h8tZQjdNRy7SadOVEDZiTFGeszQ+DU6/fuEq+90uuhF7dqUxHKWj1vuWY9J4o1OXNIRGPRKVnBKGsQmBdOp86HzibFXow5rLNdmljtv4+82EMYnCjQ/+jcdIOpviPGpeDJPc1csD6NqB9kHNEqFErytSm5vXpN57u2UoSG/Zmmx/nnfrWzArhdP/LPfrJH++KnNjjjt2yGo2BBw4lce9UdyPG4bfG2up0gcbp+lR0h8WweP0GfiEeL9EMORW0jo7+cWYpAObqGDW+jgFjq4hQ5uoYNbaI1biOvh/dHcQgJ1526h7W/3bnxDLes8+IYOvqGDb+jgG/rD+YaqPHENAz//9MMGqwA8ofV46UQZFNWcSmpywhtOVBI42AkD9xJekWp58mThBAMPQQHh1IlsgbkEaBAfod+kJ8pSj/Kz5P0s0Gx+GwtAmzb3eIfmtSjnY92irWeq9T/BWsdilAKF4IlvlqWcGbTLYiom4nMWLjlIWoJ4USLg0n6EVw4qxwB/nScb+ksLJM+GTL7UEKFQPYmut8WkSTqdZKatiWjxYghoSIP+EvzU7DyczLrr3HSEt61jWcPud+G4lNIcd3+6cxBdZvMnNWMnPKCbk0gvFha4Begaz+gwzfx6zFcl0j+ZhOIZ7qek5VBgNYbNm91aOrYXLt/gtlbFNoF0w99hbLei8P7Sa8eCuQZw1eYVGRyRejhyXBt/fMOTK8a0dBvzt//y4uLZCZtX/+W3P3vm1j/BFmzRHOgxLytudkNrlP5ARCKFyUcyq22K0qAhSUQ6dh5vFAftubVgInM6qSiq3swep9eEhbs94YgS3tD4zWPgq3Eh6cS/Yo1bE8qvS8MiY1vZXMfkb5nXzLAh+TvRvqwB7XmMt9Xzu9fG4mgrfq7J+UXh7ORj7/l7Gb61CaaFoZx2Nn85rc3t8CBB0JP+Bm1jt/RXR+NoTAmb1kwPvXjmzU9pXl2dQeSzNIHQq7FbELz8CxcYaF2D058neFKjqwY7/xdi5+ojFQJ22ji4s1CqCl+mpqdWmuG7dBgdwzhXbXJgp1dLXdEppPkwoEI/1XMm48VyqIZjwZduSrN5aeEh0PnJO3m75oDzPMzwQ7kA7uUZ8NGzTXJC7c5iAakzxwaNvprciZE8qbFUToO9u2y9ehneFSypISt3rMC6kQYOH3Eh8CTiYnOm4a2I2w1XWXshH3qUryDqD6zuQ3Mvi3Dmu8++dQphYOc3ihciK7Crk+A3sSrkKGhdjhvowGwpvRZHOn1VS+8m4VYuRTpm5JsULM12Cav6O5pA/kDWjz+A4ePvbfM4mDs2mjs+O0vHZ2vkgKcG4URrPw5nD+y3W/B3HkNzeRuXifq8VBfS1SvMzWJDXZe6tNA0W0gbUixloeNGKGzGqTfJ5SPCHKWFyoCq5YvtWTL3k/hUJ1lma/TaeD/VgQGfqkuSQyGMugZQN+E4zONPqbv+nMqG3vuxQ5a4Wnz0v8dJEp48758GTxmN/xS8ev+zoBRLop2dD864UaWukfZlcDWHt39Rw7/E5cmL0+fYDuy5YSdP//L97VtQY+md79ToQ/ZlINFMJ2fnMNHbbBgn6uTs+Zuzi5eCJximXiL2UHT6UHT6UHT6UHT68YpOdwvqvzW57oqrAbngF18c4yyXIH1RDx4RG77hT97A//wFx3+I5QHbd2YpvWdiHrWeQHJkImU/pEL0FysCGAm0Wt+EttWvbYYgC/SD8ACyPgYc/m7D9XjgMImNXRMNapeiitYensWTPOT5yrxS/ui8Fm/YbPirGpkO2PRhsHEl/+xE1ghmact0oylCp4SF+hBQM3s/tsnISCsneYMv1apVUkmZKIqlpA+K6RSoKkH1NI8p7uXu4YqQ8FU7uAYsC5oTc+1tZIM6mpuIROQ+t3b/aNBWsmsO3Eqj9dHlHI2SrIrsQXqFH7UZgsLFQ8kYa8HEW/mVReOR92qBWwRCleRmwIcBPTDQQ+oqbFnuHjW//TK+0IfnkDStZm4Ygvxy/HE9DbmSp7yC9PJdlmESD61YdvBPwRUik9OQsFqoPTQmcgfA7xvAaKkbdqP14bV77cyh00psRtz6aUxKknl+55m2ILDaXNvSsDObZPcMnGO4fjJ5oe+8sO1cwuax2N1ysAVzXf/WtrMKpW27cQ0q33YeDrfbag7v0RX8IMJg9twyhNf6c8vh4t8o/6aeVSG/4dEu0FIw4PsBy54nBaISCAe+1/MdG2bwxaqoAQGj/fZYxeXlxnAjUNrR5KCq/ZXW7Vgx1QwY8O6z4Vt9rz/qTrPW3txu0v2ng6OhkgJZ5u2Pr39ECWeBFrtZOEc+W6h/acDiiRsbRI4NV+814ipgEPqacvG+s3T7PX9qGeQa5QWHWsUKi6/rpMO+Q6DUaL2NPOXGwKKaTg5NbJJi1KjoL2dJX57jvOowl0jkLD22b/YbTbk2UvrqrfFMoXqIYZYlKky3RO/YYoTcb3bbm/OCLjOs4iTaQpgyF/eTs5evz06/frIdOKD80Qx+5xLZ9Q/VELVgTkSRvf+L+13LwPZ3I+D40oodNHB3fj0nsy9t5GYe0LtxtHkWtR/1nQ6QgwEYkM1+rVNVcfRoM72HmX6+ft2ciALm5+Ho8RZlR2xOhpHsj4rBVNuKmpMxi9rMCrebSHgu8NjmTOSb4BKRjzWdM2T7nLmiXLRClY+LUDvuCrRG8EC2pMCxR53YjrtiYko1HlfJoy/ZGXjF1Btu+n0nNsNunLZdrHn4vDyusHPb16LR1aJlXF0P3XBxo7C1cV23Z8YuLFd93Faw0oXFG20S1gjcv2ZJ9iEOjzEfKIqLUXbvit//m38NXssvy8B9LnC0yo36ectQ7p0ncJghVxnA5Lk+Gxl82+AO1iNt9uNkK1TPNQCO8a99zjjafbo3IboqyFk3JRuocaH6NcZVrEs0IxKiIKq4OzkWdUEnvWO+I1EP7cGUr2bsX+Qunoc5AI7RsViBWpHFCveNuoUrDm/iL/AjRzMBKFMKl7yn8jQYq1NwBA/mHbsdEWJ4A13k5KTwQEI3NdXmJ6tUGwqliBoMFVWjcndE3kpyKJ9dGQZ962Zt66bdm1y8aY8KY89+6sz85Yapnf56O84snfOc3FhevkMLhSliUk8l1nDoqP6dZ8cAOnRPU2AxTyfUSpCsQ/qoymsmel8RWDHrLyaUWa+P6yYwiYvSBPQ7xfgDaWIvIa6arU3KceHb6J1v3GlXsJmGYchHF7ryY4BEeOlGHOoqHd/dfmt6IFBUxRg2FxgAts0IE5WXPazdPx9Uc+xR0qN7OR4NcpVkYYQezkRxVupYqWgwVWGCaRBFmc0H93ERw+vwXbQIlz097iCJx2q0HCW+s5AzRgdreZm/yGDzKqVvIcjYLVNtwOiaYT9g8S/AFK7Z9kYMmSoIJcjSBJ0e+nzc+g4JGKy/wYey6y6nfga7oVaE24afL8hlHbKhqgkT1XwoQVPdqmWNd2hSzaaBKaJPBGYpZWqCQVpc9fhCRkaN1FLrGk1AwFODAlMHaj6bVZHJFgw0xKDtuj4pLXsh9SQWth2M8AdvdjyqAyxCgLgYPIhp04HTQ5miKcwyagEXLrS+QYSOWd+tI7QjFDyCO5GhV/4Jzus4jBNKNhjF+aiKywE2sMGLNhnTn20gYW4HinrxvRrg+0Bu++wXt2rJkqTwcIEjSrJ4kGeLNgAwSHvQ9KxhY4At2AV5sGRGHEnPiKC0zUYg7rs+25CIFkckmFF/rCJOpUYPASGbZTNjalAwkIMHAcPIluXuOD+FCzHS956fm3EUpAhhdhFGZw9NaBlJqN7h3RrEspzTQakKtyrAtvAhVXx/e/s+4CE82tAguyTanBujNdPRcjDbBzX4lDshhet5R6LEmkzY3ChOkthlkB4QbmGaXZYO4ngWEUH8rjauHcGwE3mXLUZ8y9W3L4HI61hAMWYTjJl3mtWdkmZS5/Ld+5jCjwEPsd2c9mrfYzZ6ee08VfohzRZpH2WsBxw3yuoYcb8qGgpIqCi8uE6W9UhODRaYekKZa9uePA0n4u+R4KShuoIzz6pSPRKgPNajQ1pTmJxLzQqG2gq/BugrNwUJ4aPsC8eZ4Af4IEUORmFVbCeZWgD8EdR4rNYq2GuHGJFIgVVNsyhoCKID6i6+zTgTYsZxbYztAaGdffD7pH35ZVfSUk2MQ3fdCHhY+1uqSSve1tV1BlsrlSsG4raXg6J0KzftNVLjbt7xfST1XQ6H93JD69rl5XlWrC1QtXGA1hC3XSDYkS9474ICpJLRNIzzwTDj3nEPoUtcx2Bv4qTD0Wev4taa8BYMjyqEO/kZkleHszFvZn7dLz+WLfDgLTDYlUTWwmKys3YFpXFeN2/PFsooTe6WbaVvVkw/IKfbY+3MVTCt4P4k8wNFStLoPlpwEkkD+Kaioq031XARLlsAHGXJWpPmzgiiAX1wSBeZqo/BT999wz0/2zAF1DHoEBrscxfl4SINyO1hoeNHNsDIt2D/sY9YPaB3RD1vJe66RuYCQp3MUcLb/3Kjtx96Q9MgpkVmnZk5IuHGQchJMkD2vspythOKjT2NNBJdVt/IltamhL9w22jsWZxI08txnINwiVnOUZVQhUH0qFT5SsAbStO+gN/6laANjdA6pBCGvgSph29ZCH20glaqB21tnA40EtY4AnbdGeMeII0zpuIYXnY5S/7ezB5YaPgs4kn6aJeMHtDDNlMLqlHtR6+bu3deDRNcfOMK3gqmIYirH3YXKtZCRGNaEODOgXNNqttmDIVz9cjQ0JjYwPZekf1PPNDb7RgfrMeGyBzXZbHTZjnyJBebiYfJo4ksv5jBAz04hSO7EPaCU63S94KzwALRC87JqlgDyyc1EDKKAVU8r7eIfhDUxB1ocAp4lAl0insb2PyEgdmByDG+1mPttxT8HTj3eV0scftf03qAfSTJxhhApWmhBvNkvS9k3VgP0eCGKszrOtM4ycJt3s2ijGIiBvT/xV5jFHP0u/IAaB5BJ9lDBppP93obKDG+f6hnnUDgABFCKpvIZGgn3TxX93FWFcYUrI+/b2HGggRktbLG1GwWs23V93IyS8cOKAPmwbWFuEhYYwcch8CWjMWeebpIM8Q6HRBBOuiR1d5uvMeH5nk2wdohg3nNYtYdKEA7mMFp5BV6J1HppPSLaGXj8YBF7A1Uu86tEHOijK4n5MGDITvtULcjzAD0MOLT0xdAAkssoJLTT9Z024LEkCG3KNELazBqUFf7CTFs7QcZ7BO1deviCcf1OgSJforNy7X6sg4QkVT3g4Iw4J87hqh1Ro2XPWnGkDQt2RK22AcaSFl9ujRA+4cS0OThJGuZVgIKctU4X1oPm7o+Pf1ASb3BqLqL1uXNAcFiR2XBnLF9IVqf2zM6QmsKMJatQYl+RtTem0vMqxREmLLnaZakarpH2SqZrKXiyMOsnAbWF1RfB8UJ7sNLNPBRzAGKIjtbEOQB/9auUsxrmTyMZeipsacUKBALLhUrQw9oRW5xsdXgTML5o0FC2eVcryic7wKEvk0HjxNqU/fX0jkwYT5+TJETTZ48Eht3pkWQuJZZWuP0MNuAarY22nNhJnW+HDyQTbizUShrGS4x0iMupxTDMlYLYVK4Rcw6qB1NDZ5q9OFxsILIwImJe+NFgVChgjHL7iWKEQFvxxZA0YItYAiGBeGwDwO07VrRfSJ1JzpiiK45CTS6LOeiJdKFGTY2jUIfUBN2uG/cVBtCw/kcZFWOfeAueuYbIzk04kIsJGSTGxS2pOLOsXUaELx7xMLnlJddMzUFSj3WzOS3XjsxBYP2wxxk+TB5vMWaGrjSFKWo1/nleX1b5+PODDRH9Q4TNbYw9IO34vtf1IYwY6+kFQzAT5S7on7bkhYqSR6TQbmVeleic2Ms6CYPIUD82z4GdX6XgrD21PJHyNiKB/ips9GomofaKr37+5FKwuU+a5dT028OsL13Vo+xqzei/j5INyrHuJFyX0jMWXzQeuwo+67IjvDwNRF1UmVGjEyb1m7BHUIZ5CrdxCh3t+ca0U9Gdk84sx9aA53vhmXXgLWRjz4AMDO2Gy61BXBERZsY4U5w3fBY2tgUxbpUveX5qPFgFUEKCrTwymJ6In7CrUDsH22T/eDfGhGM1D1zBDuypBh0fBQXOl/DgNmP1He6ZT6Cxf8Xo7RyXgcrbuivogABUGkiuSDa0wQEqM1x8btHSqBRshYWvwbGNqjIk0hxmdGasuY7QfbO5Ojpwf3UQRcwhnYtYLki8bsr0GT4PYHjfu6dASfD7wYcxat2sp8cCbvHZjJI3ewkA7XXNjJY3ewhg7XXBkqQUxc7KKG3e2yhANXNHgpYe22iANbNLgpge20j+4G72EWO9N5jExmkbvaQgdprCxmsbnaQwdqbkYpY1d0uLqZZIbIcpzyRW2/D3mKvmIqa0MzR5IJmoTwU80tI3io74oaVdUkM7WvbSCKPuLouaYqbhpRBotC+g9FZBqrN9PbQNWojXjekaUbfh8lY0LqhLQvcXszGgtcNcVjw9mM66EntSHyjofeS3xiojgQ4Bms/CY4B60iEY8A2b+P/A8Pnewg="
 }